@@ -21,9 +21,10 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_Grants_FullMethodName        = "/cosmos.authz.v1beta1.Query/Grants"
-	Query_GranterGrants_FullMethodName = "/cosmos.authz.v1beta1.Query/GranterGrants"
-	Query_GranteeGrants_FullMethodName = "/cosmos.authz.v1beta1.Query/GranteeGrants"
+	Query_Grants_FullMethodName              = "/cosmos.authz.v1beta1.Query/Grants"
+	Query_GranterGrants_FullMethodName       = "/cosmos.authz.v1beta1.Query/GranterGrants"
+	Query_GranteeGrants_FullMethodName       = "/cosmos.authz.v1beta1.Query/GranteeGrants"
+	Query_GranteeGrantsStream_FullMethodName = "/cosmos.authz.v1beta1.Query/GranteeGrantsStream"
 )
 
 // QueryClient is the client API for Query service.
@@ -40,6 +41,10 @@ type QueryClient interface {
 	//
 	// Since: cosmos-sdk 0.46
 	GranteeGrants(ctx context.Context, in *QueryGranteeGrantsRequest, opts ...grpc.CallOption) (*QueryGranteeGrantsResponse, error)
+	// GranteeGrantsStream streams the list of `GrantAuthorization` by grantee,
+	// one page of grants per response. The pagination of the request defines
+	// the size of each page and where to start from.
+	GranteeGrantsStream(ctx context.Context, in *QueryGranteeGrantsRequest, opts ...grpc.CallOption) (Query_GranteeGrantsStreamClient, error)
 }
 
 type queryClient struct {
@@ -77,6 +82,38 @@ func (c *queryClient) GranteeGrants(ctx context.Context, in *QueryGranteeGrantsR
 	return out, nil
 }
 
+func (c *queryClient) GranteeGrantsStream(ctx context.Context, in *QueryGranteeGrantsRequest, opts ...grpc.CallOption) (Query_GranteeGrantsStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Query_ServiceDesc.Streams[0], Query_GranteeGrantsStream_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &queryGranteeGrantsStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Query_GranteeGrantsStreamClient interface {
+	Recv() (*QueryGranteeGrantsResponse, error)
+	grpc.ClientStream
+}
+
+type queryGranteeGrantsStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *queryGranteeGrantsStreamClient) Recv() (*QueryGranteeGrantsResponse, error) {
+	m := new(QueryGranteeGrantsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -91,6 +128,10 @@ type QueryServer interface {
 	//
 	// Since: cosmos-sdk 0.46
 	GranteeGrants(context.Context, *QueryGranteeGrantsRequest) (*QueryGranteeGrantsResponse, error)
+	// GranteeGrantsStream streams the list of `GrantAuthorization` by grantee,
+	// one page of grants per response. The pagination of the request defines
+	// the size of each page and where to start from.
+	GranteeGrantsStream(*QueryGranteeGrantsRequest, Query_GranteeGrantsStreamServer) error
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -107,6 +148,9 @@ func (UnimplementedQueryServer) GranterGrants(context.Context, *QueryGranterGran
 func (UnimplementedQueryServer) GranteeGrants(context.Context, *QueryGranteeGrantsRequest) (*QueryGranteeGrantsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GranteeGrants not implemented")
 }
+func (UnimplementedQueryServer) GranteeGrantsStream(*QueryGranteeGrantsRequest, Query_GranteeGrantsStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method GranteeGrantsStream not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -174,6 +218,27 @@ func _Query_GranteeGrants_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_GranteeGrantsStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(QueryGranteeGrantsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(QueryServer).GranteeGrantsStream(m, &queryGranteeGrantsStreamServer{stream})
+}
+
+type Query_GranteeGrantsStreamServer interface {
+	Send(*QueryGranteeGrantsResponse) error
+	grpc.ServerStream
+}
+
+type queryGranteeGrantsStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *queryGranteeGrantsStreamServer) Send(m *QueryGranteeGrantsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -194,6 +259,12 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _Query_GranteeGrants_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "GranteeGrantsStream",
+			Handler:       _Query_GranteeGrantsStream_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "cosmos/authz/v1beta1/query.proto",
 }
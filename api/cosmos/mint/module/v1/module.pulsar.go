@@ -13,10 +13,59 @@ import (
 	sync "sync"
 )
 
+var _ protoreflect.List = (*_Module_3_list)(nil)
+
+type _Module_3_list struct {
+	list *[]string
+}
+
+func (x *_Module_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Module_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_Module_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Module_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Module_3_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message Module at list field HooksOrder as it is not of Message kind"))
+}
+
+func (x *_Module_3_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Module_3_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_Module_3_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_Module                    protoreflect.MessageDescriptor
-	fd_Module_fee_collector_name protoreflect.FieldDescriptor
-	fd_Module_authority          protoreflect.FieldDescriptor
+	md_Module                     protoreflect.MessageDescriptor
+	fd_Module_fee_collector_name  protoreflect.FieldDescriptor
+	fd_Module_authority           protoreflect.FieldDescriptor
+	fd_Module_hooks_order         protoreflect.FieldDescriptor
+	fd_Module_enforce_bond_denom  protoreflect.FieldDescriptor
+	fd_Module_mint_failure_policy protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -24,6 +73,9 @@ func init() {
 	md_Module = File_cosmos_mint_module_v1_module_proto.Messages().ByName("Module")
 	fd_Module_fee_collector_name = md_Module.Fields().ByName("fee_collector_name")
 	fd_Module_authority = md_Module.Fields().ByName("authority")
+	fd_Module_hooks_order = md_Module.Fields().ByName("hooks_order")
+	fd_Module_enforce_bond_denom = md_Module.Fields().ByName("enforce_bond_denom")
+	fd_Module_mint_failure_policy = md_Module.Fields().ByName("mint_failure_policy")
 }
 
 var _ protoreflect.Message = (*fastReflection_Module)(nil)
@@ -103,6 +155,24 @@ func (x *fastReflection_Module) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if len(x.HooksOrder) != 0 {
+		value := protoreflect.ValueOfList(&_Module_3_list{list: &x.HooksOrder})
+		if !f(fd_Module_hooks_order, value) {
+			return
+		}
+	}
+	if x.EnforceBondDenom != false {
+		value := protoreflect.ValueOfBool(x.EnforceBondDenom)
+		if !f(fd_Module_enforce_bond_denom, value) {
+			return
+		}
+	}
+	if x.MintFailurePolicy != "" {
+		value := protoreflect.ValueOfString(x.MintFailurePolicy)
+		if !f(fd_Module_mint_failure_policy, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -122,6 +192,12 @@ func (x *fastReflection_Module) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.FeeCollectorName != ""
 	case "cosmos.mint.module.v1.Module.authority":
 		return x.Authority != ""
+	case "cosmos.mint.module.v1.Module.hooks_order":
+		return len(x.HooksOrder) != 0
+	case "cosmos.mint.module.v1.Module.enforce_bond_denom":
+		return x.EnforceBondDenom != false
+	case "cosmos.mint.module.v1.Module.mint_failure_policy":
+		return x.MintFailurePolicy != ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.mint.module.v1.Module"))
@@ -142,6 +218,12 @@ func (x *fastReflection_Module) Clear(fd protoreflect.FieldDescriptor) {
 		x.FeeCollectorName = ""
 	case "cosmos.mint.module.v1.Module.authority":
 		x.Authority = ""
+	case "cosmos.mint.module.v1.Module.hooks_order":
+		x.HooksOrder = nil
+	case "cosmos.mint.module.v1.Module.enforce_bond_denom":
+		x.EnforceBondDenom = false
+	case "cosmos.mint.module.v1.Module.mint_failure_policy":
+		x.MintFailurePolicy = ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.mint.module.v1.Module"))
@@ -164,6 +246,18 @@ func (x *fastReflection_Module) Get(descriptor protoreflect.FieldDescriptor) pro
 	case "cosmos.mint.module.v1.Module.authority":
 		value := x.Authority
 		return protoreflect.ValueOfString(value)
+	case "cosmos.mint.module.v1.Module.hooks_order":
+		if len(x.HooksOrder) == 0 {
+			return protoreflect.ValueOfList(&_Module_3_list{})
+		}
+		listValue := &_Module_3_list{list: &x.HooksOrder}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.mint.module.v1.Module.enforce_bond_denom":
+		value := x.EnforceBondDenom
+		return protoreflect.ValueOfBool(value)
+	case "cosmos.mint.module.v1.Module.mint_failure_policy":
+		value := x.MintFailurePolicy
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.mint.module.v1.Module"))
@@ -188,6 +282,14 @@ func (x *fastReflection_Module) Set(fd protoreflect.FieldDescriptor, value proto
 		x.FeeCollectorName = value.Interface().(string)
 	case "cosmos.mint.module.v1.Module.authority":
 		x.Authority = value.Interface().(string)
+	case "cosmos.mint.module.v1.Module.hooks_order":
+		lv := value.List()
+		clv := lv.(*_Module_3_list)
+		x.HooksOrder = *clv.list
+	case "cosmos.mint.module.v1.Module.enforce_bond_denom":
+		x.EnforceBondDenom = value.Bool()
+	case "cosmos.mint.module.v1.Module.mint_failure_policy":
+		x.MintFailurePolicy = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.mint.module.v1.Module"))
@@ -208,10 +310,20 @@ func (x *fastReflection_Module) Set(fd protoreflect.FieldDescriptor, value proto
 // Mutable is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_Module) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "cosmos.mint.module.v1.Module.hooks_order":
+		if x.HooksOrder == nil {
+			x.HooksOrder = []string{}
+		}
+		value := &_Module_3_list{list: &x.HooksOrder}
+		return protoreflect.ValueOfList(value)
 	case "cosmos.mint.module.v1.Module.fee_collector_name":
 		panic(fmt.Errorf("field fee_collector_name of message cosmos.mint.module.v1.Module is not mutable"))
 	case "cosmos.mint.module.v1.Module.authority":
 		panic(fmt.Errorf("field authority of message cosmos.mint.module.v1.Module is not mutable"))
+	case "cosmos.mint.module.v1.Module.enforce_bond_denom":
+		panic(fmt.Errorf("field enforce_bond_denom of message cosmos.mint.module.v1.Module is not mutable"))
+	case "cosmos.mint.module.v1.Module.mint_failure_policy":
+		panic(fmt.Errorf("field mint_failure_policy of message cosmos.mint.module.v1.Module is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.mint.module.v1.Module"))
@@ -229,6 +341,13 @@ func (x *fastReflection_Module) NewField(fd protoreflect.FieldDescriptor) protor
 		return protoreflect.ValueOfString("")
 	case "cosmos.mint.module.v1.Module.authority":
 		return protoreflect.ValueOfString("")
+	case "cosmos.mint.module.v1.Module.hooks_order":
+		list := []string{}
+		return protoreflect.ValueOfList(&_Module_3_list{list: &list})
+	case "cosmos.mint.module.v1.Module.enforce_bond_denom":
+		return protoreflect.ValueOfBool(false)
+	case "cosmos.mint.module.v1.Module.mint_failure_policy":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.mint.module.v1.Module"))
@@ -306,6 +425,19 @@ func (x *fastReflection_Module) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if len(x.HooksOrder) > 0 {
+			for _, s := range x.HooksOrder {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.EnforceBondDenom {
+			n += 2
+		}
+		l = len(x.MintFailurePolicy)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -335,6 +467,32 @@ func (x *fastReflection_Module) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.MintFailurePolicy) > 0 {
+			i -= len(x.MintFailurePolicy)
+			copy(dAtA[i:], x.MintFailurePolicy)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MintFailurePolicy)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if x.EnforceBondDenom {
+			i--
+			if x.EnforceBondDenom {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.HooksOrder) > 0 {
+			for iNdEx := len(x.HooksOrder) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.HooksOrder[iNdEx])
+				copy(dAtA[i:], x.HooksOrder[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.HooksOrder[iNdEx])))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
 		if len(x.Authority) > 0 {
 			i -= len(x.Authority)
 			copy(dAtA[i:], x.Authority)
@@ -462,6 +620,90 @@ func (x *fastReflection_Module) ProtoMethods() *protoiface.Methods {
 				}
 				x.Authority = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field HooksOrder", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.HooksOrder = append(x.HooksOrder, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EnforceBondDenom", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.EnforceBondDenom = bool(v != 0)
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MintFailurePolicy", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MintFailurePolicy = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -519,6 +761,16 @@ type Module struct {
 	FeeCollectorName string `protobuf:"bytes,1,opt,name=fee_collector_name,json=feeCollectorName,proto3" json:"fee_collector_name,omitempty"`
 	// authority defines the custom module authority. If not set, defaults to the governance module.
 	Authority string `protobuf:"bytes,2,opt,name=authority,proto3" json:"authority,omitempty"`
+	// hooks_order specifies the order of mint hooks and should be a list
+	// of module names which provide a mint hooks instance. If no order is
+	// provided, then hooks will be applied in alphabetical order of module names.
+	HooksOrder []string `protobuf:"bytes,3,rep,name=hooks_order,json=hooksOrder,proto3" json:"hooks_order,omitempty"`
+	// enforce_bond_denom makes BeginBlocker fail when the mint denom differs
+	// from the staking bond denom.
+	EnforceBondDenom bool `protobuf:"varint,4,opt,name=enforce_bond_denom,json=enforceBondDenom,proto3" json:"enforce_bond_denom,omitempty"`
+	// mint_failure_policy defines how BeginBlocker handles a failure to mint the
+	// block provision, either "halt" or "skip". If not set, defaults to "halt".
+	MintFailurePolicy string `protobuf:"bytes,5,opt,name=mint_failure_policy,json=mintFailurePolicy,proto3" json:"mint_failure_policy,omitempty"`
 }
 
 func (x *Module) Reset() {
@@ -555,6 +807,27 @@ func (x *Module) GetAuthority() string {
 	return ""
 }
 
+func (x *Module) GetHooksOrder() []string {
+	if x != nil {
+		return x.HooksOrder
+	}
+	return nil
+}
+
+func (x *Module) GetEnforceBondDenom() bool {
+	if x != nil {
+		return x.EnforceBondDenom
+	}
+	return false
+}
+
+func (x *Module) GetMintFailurePolicy() string {
+	if x != nil {
+		return x.MintFailurePolicy
+	}
+	return ""
+}
+
 var File_cosmos_mint_module_v1_module_proto protoreflect.FileDescriptor
 
 var file_cosmos_mint_module_v1_module_proto_rawDesc = []byte{
@@ -563,13 +836,21 @@ var file_cosmos_mint_module_v1_module_proto_rawDesc = []byte{
 	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x6d, 0x69, 0x6e,
 	0x74, 0x2e, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x1a, 0x20, 0x63, 0x6f, 0x73,
 	0x6d, 0x6f, 0x73, 0x2f, 0x61, 0x70, 0x70, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
-	0x2f, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x71, 0x0a,
-	0x06, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x66, 0x65, 0x65, 0x5f, 0x63,
-	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x10, 0x66, 0x65, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f,
-	0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69,
-	0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72,
-	0x69, 0x74, 0x79, 0x3a, 0x1b, 0xba, 0xc0, 0x96, 0xda, 0x01, 0x15, 0x0a, 0x13, 0x63, 0x6f, 0x73,
+	0x2f, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xf0, 0x01,
+	0x0a, 0x06, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x66, 0x65, 0x65, 0x5f,
+	0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x66, 0x65, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72,
+	0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f,
+	0x72, 0x69, 0x74, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x5f, 0x6f, 0x72,
+	0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x68, 0x6f, 0x6f, 0x6b, 0x73,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x2c, 0x0a, 0x12, 0x65, 0x6e, 0x66, 0x6f, 0x72, 0x63, 0x65,
+	0x5f, 0x62, 0x6f, 0x6e, 0x64, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x10, 0x65, 0x6e, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x42, 0x6f, 0x6e, 0x64, 0x44, 0x65,
+	0x6e, 0x6f, 0x6d, 0x12, 0x2e, 0x0a, 0x13, 0x6d, 0x69, 0x6e, 0x74, 0x5f, 0x66, 0x61, 0x69, 0x6c,
+	0x75, 0x72, 0x65, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x11, 0x6d, 0x69, 0x6e, 0x74, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x3a, 0x1b, 0xba, 0xc0, 0x96, 0xda, 0x01, 0x15, 0x0a, 0x13, 0x63, 0x6f, 0x73,
 	0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x78, 0x2f, 0x6d, 0x69, 0x6e, 0x74,
 	0x42, 0xd0, 0x01, 0x0a, 0x19, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
 	0x6d, 0x69, 0x6e, 0x74, 0x2e, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x42, 0x0b,
@@ -19,9 +19,12 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_Params_FullMethodName           = "/cosmos.mint.v1beta1.Query/Params"
-	Query_Inflation_FullMethodName        = "/cosmos.mint.v1beta1.Query/Inflation"
-	Query_AnnualProvisions_FullMethodName = "/cosmos.mint.v1beta1.Query/AnnualProvisions"
+	Query_Params_FullMethodName              = "/cosmos.mint.v1beta1.Query/Params"
+	Query_Inflation_FullMethodName           = "/cosmos.mint.v1beta1.Query/Inflation"
+	Query_AnnualProvisions_FullMethodName    = "/cosmos.mint.v1beta1.Query/AnnualProvisions"
+	Query_LastInflationInputs_FullMethodName = "/cosmos.mint.v1beta1.Query/LastInflationInputs"
+	Query_InflationHistory_FullMethodName    = "/cosmos.mint.v1beta1.Query/InflationHistory"
+	Query_ProjectedProvisions_FullMethodName = "/cosmos.mint.v1beta1.Query/ProjectedProvisions"
 )
 
 // QueryClient is the client API for Query service.
@@ -34,6 +37,16 @@ type QueryClient interface {
 	Inflation(ctx context.Context, in *QueryInflationRequest, opts ...grpc.CallOption) (*QueryInflationResponse, error)
 	// AnnualProvisions current minting annual provisions value.
 	AnnualProvisions(ctx context.Context, in *QueryAnnualProvisionsRequest, opts ...grpc.CallOption) (*QueryAnnualProvisionsResponse, error)
+	// LastInflationInputs returns the inputs used by the last inflation
+	// computation.
+	LastInflationInputs(ctx context.Context, in *QueryLastInflationInputsRequest, opts ...grpc.CallOption) (*QueryLastInflationInputsResponse, error)
+	// InflationHistory returns the minter state of the last blocks, as kept
+	// according to the inflation_history_size param.
+	InflationHistory(ctx context.Context, in *QueryInflationHistoryRequest, opts ...grpc.CallOption) (*QueryInflationHistoryResponse, error)
+	// ProjectedProvisions returns the inflation and annual provisions the next
+	// block would have for a hypothetical bonded ratio, given the current minter
+	// state, params and staking token supply.
+	ProjectedProvisions(ctx context.Context, in *QueryProjectedProvisionsRequest, opts ...grpc.CallOption) (*QueryProjectedProvisionsResponse, error)
 }
 
 type queryClient struct {
@@ -71,6 +84,33 @@ func (c *queryClient) AnnualProvisions(ctx context.Context, in *QueryAnnualProvi
 	return out, nil
 }
 
+func (c *queryClient) LastInflationInputs(ctx context.Context, in *QueryLastInflationInputsRequest, opts ...grpc.CallOption) (*QueryLastInflationInputsResponse, error) {
+	out := new(QueryLastInflationInputsResponse)
+	err := c.cc.Invoke(ctx, Query_LastInflationInputs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) InflationHistory(ctx context.Context, in *QueryInflationHistoryRequest, opts ...grpc.CallOption) (*QueryInflationHistoryResponse, error) {
+	out := new(QueryInflationHistoryResponse)
+	err := c.cc.Invoke(ctx, Query_InflationHistory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ProjectedProvisions(ctx context.Context, in *QueryProjectedProvisionsRequest, opts ...grpc.CallOption) (*QueryProjectedProvisionsResponse, error) {
+	out := new(QueryProjectedProvisionsResponse)
+	err := c.cc.Invoke(ctx, Query_ProjectedProvisions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -81,6 +121,16 @@ type QueryServer interface {
 	Inflation(context.Context, *QueryInflationRequest) (*QueryInflationResponse, error)
 	// AnnualProvisions current minting annual provisions value.
 	AnnualProvisions(context.Context, *QueryAnnualProvisionsRequest) (*QueryAnnualProvisionsResponse, error)
+	// LastInflationInputs returns the inputs used by the last inflation
+	// computation.
+	LastInflationInputs(context.Context, *QueryLastInflationInputsRequest) (*QueryLastInflationInputsResponse, error)
+	// InflationHistory returns the minter state of the last blocks, as kept
+	// according to the inflation_history_size param.
+	InflationHistory(context.Context, *QueryInflationHistoryRequest) (*QueryInflationHistoryResponse, error)
+	// ProjectedProvisions returns the inflation and annual provisions the next
+	// block would have for a hypothetical bonded ratio, given the current minter
+	// state, params and staking token supply.
+	ProjectedProvisions(context.Context, *QueryProjectedProvisionsRequest) (*QueryProjectedProvisionsResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -97,6 +147,15 @@ func (UnimplementedQueryServer) Inflation(context.Context, *QueryInflationReques
 func (UnimplementedQueryServer) AnnualProvisions(context.Context, *QueryAnnualProvisionsRequest) (*QueryAnnualProvisionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AnnualProvisions not implemented")
 }
+func (UnimplementedQueryServer) LastInflationInputs(context.Context, *QueryLastInflationInputsRequest) (*QueryLastInflationInputsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LastInflationInputs not implemented")
+}
+func (UnimplementedQueryServer) InflationHistory(context.Context, *QueryInflationHistoryRequest) (*QueryInflationHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InflationHistory not implemented")
+}
+func (UnimplementedQueryServer) ProjectedProvisions(context.Context, *QueryProjectedProvisionsRequest) (*QueryProjectedProvisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProjectedProvisions not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -164,6 +223,60 @@ func _Query_AnnualProvisions_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_LastInflationInputs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLastInflationInputsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LastInflationInputs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_LastInflationInputs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LastInflationInputs(ctx, req.(*QueryLastInflationInputsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_InflationHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryInflationHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).InflationHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_InflationHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).InflationHistory(ctx, req.(*QueryInflationHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProjectedProvisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProjectedProvisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProjectedProvisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ProjectedProvisions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProjectedProvisions(ctx, req.(*QueryProjectedProvisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -183,6 +296,18 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AnnualProvisions",
 			Handler:    _Query_AnnualProvisions_Handler,
 		},
+		{
+			MethodName: "LastInflationInputs",
+			Handler:    _Query_LastInflationInputs_Handler,
+		},
+		{
+			MethodName: "InflationHistory",
+			Handler:    _Query_InflationHistory_Handler,
+		},
+		{
+			MethodName: "ProjectedProvisions",
+			Handler:    _Query_ProjectedProvisions_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/mint/v1beta1/query.proto",
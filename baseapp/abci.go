@@ -1,22 +1,33 @@
 package baseapp
 
 import (
+	"cmp"
 	"context"
+	"crypto/sha256"
+	"encoding/json"
 	"fmt"
-	"sort"
+	"runtime/debug"
+	"slices"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/cockroachdb/errors"
 	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/crypto"
+	cmtbytes "github.com/cometbft/cometbft/libs/bytes"
 	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	cmttypes "github.com/cometbft/cometbft/types"
 	"github.com/cosmos/gogoproto/proto"
+	"github.com/hashicorp/go-metrics"
 	"google.golang.org/grpc/codes"
 	grpcstatus "google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protowire"
 
 	corecomet "cosmossdk.io/core/comet"
 	coreheader "cosmossdk.io/core/header"
 	errorsmod "cosmossdk.io/errors"
+	pruningtypes "cosmossdk.io/store/pruning/types"
 	"cosmossdk.io/store/rootmulti"
 	snapshottypes "cosmossdk.io/store/snapshots/types"
 	storetypes "cosmossdk.io/store/types"
@@ -27,6 +38,10 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
+// CheckStateQueryHeight is the query height selecting the uncommitted check
+// state, when enabled with SetCheckStateQueries.
+const CheckStateQueryHeight int64 = -1
+
 // Supported ABCI Query prefixes and paths
 const (
 	QueryPathApp    = "app"
@@ -37,6 +52,14 @@ const (
 	QueryPathBroadcastTx = "/cosmos.tx.v1beta1.Service/BroadcastTx"
 )
 
+// Encodings of the /app/simulate query response, selected by an optional path
+// segment, e.g. /app/simulate/proto. The chosen encoding is returned in the
+// response log as "encoding=<encoding>".
+const (
+	SimulateEncodingJSON  = "json"
+	SimulateEncodingProto = "proto"
+)
+
 func (app *BaseApp) InitChain(req *abci.RequestInitChain) (*abci.ResponseInitChain, error) {
 	if req.ChainId != app.chainID {
 		return nil, fmt.Errorf("invalid chain-id on InitChain; expected: %s, got: %s", app.chainID, req.ChainId)
@@ -53,12 +76,16 @@ func (app *BaseApp) InitChain(req *abci.RequestInitChain) (*abci.ResponseInitCha
 	if app.initialHeight == 0 { // If initial height is 0, set it to 1
 		app.initialHeight = 1
 	}
+	if err := app.storeInitialHeight(); err != nil {
+		return nil, err
+	}
 
 	// if req.InitialHeight is > 1, then we set the initial version on all stores
 	if req.InitialHeight > 1 {
 		initHeader.Height = req.InitialHeight
 		if err := app.cms.SetInitialVersion(req.InitialHeight); err != nil {
-			return nil, err
+			app.logger.Error("failed to set initial version", "initialHeight", req.InitialHeight, "chainID", req.ChainId, "err", err)
+			return nil, fmt.Errorf("failed to set initial version %d for chain %s: %w", req.InitialHeight, req.ChainId, err)
 		}
 	}
 
@@ -74,6 +101,14 @@ func (app *BaseApp) InitChain(req *abci.RequestInitChain) (*abci.ResponseInitCha
 		if err != nil {
 			return nil, err
 		}
+	} else if app.initChainDefaultConsensusParams && app.paramStore != nil {
+		// Without consensus params GetConsensusParams returns an empty set, i.e.
+		// no block size limits, no evidence max age for block retention and no
+		// validator key types, so fall back to CometBFT's defaults.
+		app.logger.Info("no consensus params in InitChain; storing default consensus params")
+		if err := app.StoreConsensusParams(app.finalizeBlockState.Context(), DefaultConsensusParams()); err != nil {
+			return nil, err
+		}
 	}
 
 	defer func() {
@@ -82,7 +117,8 @@ func (app *BaseApp) InitChain(req *abci.RequestInitChain) (*abci.ResponseInitCha
 		// handler, the block height is zero by default. However, after Commit is called
 		// the height needs to reflect the true block height.
 		initHeader.Height = req.InitialHeight
-		app.checkState.SetContext(app.checkState.Context().WithBlockHeader(initHeader).
+		checkState := app.checkState.Load()
+		checkState.SetContext(checkState.Context().WithBlockHeader(initHeader).
 			WithHeaderInfo(coreheader.Info{
 				ChainID: req.ChainId,
 				Height:  req.InitialHeight,
@@ -97,6 +133,7 @@ func (app *BaseApp) InitChain(req *abci.RequestInitChain) (*abci.ResponseInitCha
 	}()
 
 	if app.initChainer == nil {
+		app.seedValidatorSet(req.Validators)
 		return &abci.ResponseInitChain{}, nil
 	}
 
@@ -109,32 +146,80 @@ func (app *BaseApp) InitChain(req *abci.RequestInitChain) (*abci.ResponseInitCha
 	}
 
 	if len(req.Validators) > 0 {
-		if len(req.Validators) != len(res.Validators) {
-			return nil, fmt.Errorf(
-				"len(RequestInitChain.Validators) != len(GenesisValidators) (%d != %d)",
-				len(req.Validators), len(res.Validators),
-			)
-		}
-
-		sort.Sort(abci.ValidatorUpdates(req.Validators))
-		sort.Sort(abci.ValidatorUpdates(res.Validators))
-
-		for i := range res.Validators {
-			if !proto.Equal(&res.Validators[i], &req.Validators[i]) {
-				return nil, fmt.Errorf("genesisValidators[%d] != req.Validators[%d] ", i, i)
+		if err := validateGenesisValidators(req.Validators, res.Validators); err != nil {
+			if !app.allowGenesisValidatorsMismatch {
+				return nil, err
 			}
+
+			app.logger.Error("genesis validators mismatch; continuing with the validators returned by the application", "err", err)
 		}
 	}
 
+	if len(res.Validators) > 0 {
+		app.seedValidatorSet(res.Validators)
+	} else {
+		app.seedValidatorSet(req.Validators)
+	}
+
 	// NOTE: We don't commit, but FinalizeBlock for block InitialHeight starts from
 	// this FinalizeBlockState.
 	return &abci.ResponseInitChain{
 		ConsensusParams: res.ConsensusParams,
 		Validators:      res.Validators,
-		AppHash:         app.LastCommitID().Hash,
+		AppHash:         app.initChainAppHash(),
 	}, nil
 }
 
+// DefaultConsensusParams returns the consensus params InitChain stores when
+// the request carries none, see SetInitChainDefaultConsensusParams. They are CometBFT's defaults, i.e. a 21MB block
+// size limit, no block gas limit, an evidence age of 100000 blocks or 48 hours
+// and ed25519 validator keys.
+func DefaultConsensusParams() cmtproto.ConsensusParams {
+	return cmttypes.DefaultConsensusParams().ToProto()
+}
+
+// initChainAppHash returns the app hash reported by InitChain. For a new chain,
+// i.e. when nothing has been committed yet and LastCommitID is zero, it is the
+// sha256 hash of the empty string. Otherwise, e.g. when the chain is started on
+// top of migrated state, it is the last committed app hash.
+func (app *BaseApp) initChainAppHash() []byte {
+	lastCommitID := app.LastCommitID()
+	if lastCommitID.IsZero() {
+		emptyHash := sha256.Sum256([]byte{})
+		return emptyHash[:]
+	}
+
+	return app.lastBlockAppHash()
+}
+
+// lastBlockAppHash returns the app hash of the last committed block, as
+// returned to CometBFT by FinalizeBlock, i.e. the store hash derived with the
+// app hash mixer if one is set. The mixed hash is kept from FinalizeBlock, or
+// derived again with a query context over the last committed state after a
+// restart.
+func (app *BaseApp) lastBlockAppHash() []byte {
+	lastCommitID := app.cms.LastCommitID()
+	if app.appHashMixer == nil || lastCommitID.Version == 0 {
+		return lastCommitID.Hash
+	}
+
+	if app.mixedAppHash.height == lastCommitID.Version {
+		return app.mixedAppHash.hash
+	}
+
+	ctx, err := app.CreateQueryContext(lastCommitID.Version, false)
+	if err != nil {
+		panic(fmt.Errorf("failed creating query context to mix the last app hash: %w", err))
+	}
+
+	// the block height of query contexts is the one of the check state, which
+	// is not set yet after a restart
+	hash := app.appHashMixer(ctx.WithBlockHeight(lastCommitID.Version), lastCommitID.Hash)
+	app.mixedAppHash = mixedAppHash{height: lastCommitID.Version, hash: hash}
+
+	return hash
+}
+
 func (app *BaseApp) Info(_ *abci.RequestInfo) (*abci.ResponseInfo, error) {
 	lastCommitID := app.cms.LastCommitID()
 	appVersion := InitialAppVersion
@@ -154,18 +239,74 @@ func (app *BaseApp) Info(_ *abci.RequestInfo) (*abci.ResponseInfo, error) {
 		Version:          app.version,
 		AppVersion:       appVersion,
 		LastBlockHeight:  lastCommitID.Version,
-		LastBlockAppHash: lastCommitID.Hash,
+		LastBlockAppHash: app.lastBlockAppHash(),
 	}, nil
 }
 
+// validateGenesisValidators checks that the validators returned by the
+// application's InitChainer match the ones provided by CometBFT in InitChain.
+// Both slices are sorted in place.
+func validateGenesisValidators(reqVals, resVals []abci.ValidatorUpdate) error {
+	if len(reqVals) != len(resVals) {
+		return fmt.Errorf(
+			"len(RequestInitChain.Validators) != len(GenesisValidators) (%d != %d)",
+			len(reqVals), len(resVals),
+		)
+	}
+
+	sortValidatorUpdates(reqVals)
+	sortValidatorUpdates(resVals)
+
+	for i := range resVals {
+		if !proto.Equal(&resVals[i], &reqVals[i]) {
+			return fmt.Errorf(
+				"genesisValidators[%d] != req.Validators[%d]: genesis validator {pubkey: %s, power: %d}, requested validator {pubkey: %s, power: %d}",
+				i, i, resVals[i].PubKey.String(), resVals[i].Power, reqVals[i].PubKey.String(), reqVals[i].Power,
+			)
+		}
+	}
+
+	return nil
+}
+
+// sortValidatorUpdates sorts validator updates by public key, then by power.
+// Unlike abci.ValidatorUpdates, which only compares public keys, this is a
+// total order, so that equal sets of updates are sorted identically whatever
+// their input order.
+func sortValidatorUpdates(vals []abci.ValidatorUpdate) {
+	slices.SortFunc(vals, func(a, b abci.ValidatorUpdate) int {
+		if c := a.PubKey.Compare(b.PubKey); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.Power, b.Power)
+	})
+}
+
 // Query implements the ABCI interface. It delegates to CommitMultiStore if it
 // implements Queryable.
 func (app *BaseApp) Query(_ context.Context, req *abci.RequestQuery) (resp *abci.ResponseQuery, err error) {
+	// registered first so that it also sees the results of recovered panics
+	if app.queryErrorInfo {
+		defer func() {
+			if resp != nil && !resp.IsOK() {
+				resp.Info = encodeQueryInfo(QueryInfo{Error: &QueryErrorInfo{
+					Code:      resp.Code,
+					Codespace: resp.Codespace,
+					Message:   resp.Log,
+				}})
+			}
+		}()
+	}
+
 	// add panic recovery for all queries
 	//
 	// Ref: https://github.com/cosmos/cosmos-sdk/pull/8039
 	defer func() {
 		if r := recover(); r != nil {
+			if app.queryPanicRethrow {
+				app.logger.Error("panic while handling query", "path", req.Path, "err", r, "stack", string(debug.Stack()))
+				panic(r)
+			}
 			resp = sdkerrors.QueryResult(errorsmod.Wrapf(sdkerrors.ErrPanic, "%v", r), app.trace)
 		}
 	}()
@@ -280,6 +421,7 @@ func (app *BaseApp) OfferSnapshot(req *abci.RequestOfferSnapshot) (*abci.Respons
 	err = app.snapshotManager.Restore(snapshot)
 	switch {
 	case err == nil:
+		app.snapshotChunkRejects = nil
 		return &abci.ResponseOfferSnapshot{Result: abci.ResponseOfferSnapshot_ACCEPT}, nil
 
 	case errors.Is(err, snapshottypes.ErrUnknownFormat):
@@ -302,8 +444,11 @@ func (app *BaseApp) OfferSnapshot(req *abci.RequestOfferSnapshot) (*abci.Respons
 			"err", err,
 		)
 
-		// We currently don't support resetting the IAVL stores and retrying a
-		// different snapshot, so we ask CometBFT to abort all snapshot restoration.
+		// Another snapshot can only be restored if the stores can be reset,
+		// otherwise we ask CometBFT to abort all snapshot restoration.
+		if app.resetSnapshotStores() {
+			return &abci.ResponseOfferSnapshot{Result: abci.ResponseOfferSnapshot_REJECT}, nil
+		}
 		return &abci.ResponseOfferSnapshot{Result: abci.ResponseOfferSnapshot_ABORT}, nil
 	}
 }
@@ -315,26 +460,23 @@ func (app *BaseApp) ApplySnapshotChunk(req *abci.RequestApplySnapshotChunk) (*ab
 		return &abci.ResponseApplySnapshotChunk{Result: abci.ResponseApplySnapshotChunk_ABORT}, nil
 	}
 
-	_, err := app.snapshotManager.RestoreChunk(req.Chunk)
+	done, err := app.snapshotManager.RestoreChunk(req.Chunk)
 	switch {
 	case err == nil:
+		if done {
+			app.snapshotChunkRejects = nil
+		}
 		return &abci.ResponseApplySnapshotChunk{Result: abci.ResponseApplySnapshotChunk_ACCEPT}, nil
 
 	case errors.Is(err, snapshottypes.ErrChunkHashMismatch):
-		app.logger.Error(
-			"chunk checksum mismatch; rejecting sender and requesting refetch",
-			"chunk", req.Index,
-			"sender", req.Sender,
-			"err", err,
-		)
-		return &abci.ResponseApplySnapshotChunk{
-			Result:        abci.ResponseApplySnapshotChunk_RETRY,
-			RefetchChunks: []uint32{req.Index},
-			RejectSenders: []string{req.Sender},
-		}, nil
+		return app.rejectSnapshotChunk(req, err), nil
 
 	default:
 		app.logger.Error("failed to restore snapshot", "err", err)
+		app.snapshotChunkRejects = nil
+		if app.resetSnapshotStores() {
+			return &abci.ResponseApplySnapshotChunk{Result: abci.ResponseApplySnapshotChunk_REJECT_SNAPSHOT}, nil
+		}
 		return &abci.ResponseApplySnapshotChunk{Result: abci.ResponseApplySnapshotChunk_ABORT}, nil
 	}
 }
@@ -346,20 +488,31 @@ func (app *BaseApp) ApplySnapshotChunk(req *abci.RequestApplySnapshotChunk) (*ab
 // will contain relevant error information. Regardless of tx execution outcome,
 // the ResponseCheckTx will contain relevant gas execution context.
 func (app *BaseApp) CheckTx(req *abci.RequestCheckTx) (*abci.ResponseCheckTx, error) {
-	var mode execMode
+	var (
+		mode        execMode
+		checkTxType string
+	)
 
 	switch {
 	case req.Type == abci.CheckTxType_New:
 		mode = execModeCheck
+		checkTxType = "new"
 
 	case req.Type == abci.CheckTxType_Recheck:
 		mode = execModeReCheck
+		checkTxType = "recheck"
 
 	default:
 		return nil, fmt.Errorf("unknown RequestCheckTx type: %s", req.Type)
 	}
 
 	gInfo, result, anteEvents, err := app.runTx(mode, req.Tx)
+
+	labels := []metrics.Label{telemetry.NewLabel("type", checkTxType)}
+	telemetry.IncrCounterWithLabels([]string{"checktx"}, 1, labels)
+	telemetry.SetGaugeWithLabels([]string{"checktx", "gas", "used"}, float32(gInfo.GasUsed), labels)
+	telemetry.SetGaugeWithLabels([]string{"checktx", "gas", "wanted"}, float32(gInfo.GasWanted), labels)
+
 	if err != nil {
 		return sdkerrors.ResponseCheckTxWithEvents(err, gInfo.GasWanted, gInfo.GasUsed, anteEvents, app.trace), nil
 	}
@@ -391,15 +544,17 @@ func (app *BaseApp) PrepareProposal(req *abci.RequestPrepareProposal) (resp *abc
 		return nil, errors.New("PrepareProposal handler not set")
 	}
 
+	blockTime := app.proposalTime(req)
+
 	// Always reset state given that PrepareProposal can timeout and be called
 	// again in a subsequent round.
 	header := cmtproto.Header{
 		ChainID:            app.chainID,
 		Height:             req.Height,
-		Time:               req.Time,
+		Time:               blockTime,
 		ProposerAddress:    req.ProposerAddress,
 		NextValidatorsHash: req.NextValidatorsHash,
-		AppHash:            app.LastCommitID().Hash,
+		AppHash:            app.lastBlockAppHash(),
 	}
 	app.setState(execModePrepareProposal, header)
 
@@ -424,7 +579,7 @@ func (app *BaseApp) PrepareProposal(req *abci.RequestPrepareProposal) (resp *abc
 		WithHeaderInfo(coreheader.Info{
 			ChainID: app.chainID,
 			Height:  req.Height,
-			Time:    req.Time,
+			Time:    blockTime,
 		}))
 
 	app.prepareProposalState.SetContext(app.prepareProposalState.Context().
@@ -440,19 +595,78 @@ func (app *BaseApp) PrepareProposal(req *abci.RequestPrepareProposal) (resp *abc
 				"panic", err,
 			)
 
-			resp = &abci.ResponsePrepareProposal{Txs: req.Txs}
+			resp = &abci.ResponsePrepareProposal{Txs: req.Txs[:fittingTxsCount(req.Txs, req.MaxTxBytes)]}
 		}
 	}()
 
 	resp, err = app.prepareProposal(app.prepareProposalState.Context(), req)
 	if err != nil {
 		app.logger.Error("failed to prepare proposal", "height", req.Height, "time", req.Time, "err", err)
-		return &abci.ResponsePrepareProposal{Txs: req.Txs}, nil
+		return &abci.ResponsePrepareProposal{Txs: req.Txs[:fittingTxsCount(req.Txs, req.MaxTxBytes)]}, nil
 	}
 
+	txs, err := app.fitProposalTxs(req, resp.Txs)
+	if err != nil {
+		return nil, err
+	}
+	resp.Txs = txs
+
 	return resp, nil
 }
 
+// proposalTime returns the block time to use for the given PrepareProposal
+// request. When maxProposalTimeSkew is set, a time further ahead of the local
+// clock is clamped to the local time plus the skew, so that time-dependent
+// module logic does not run with an implausible time.
+func (app *BaseApp) proposalTime(req *abci.RequestPrepareProposal) time.Time {
+	if app.maxProposalTimeSkew <= 0 {
+		return req.Time
+	}
+
+	maxTime := app.clock.Now().Add(app.maxProposalTimeSkew)
+	if !req.Time.After(maxTime) {
+		return req.Time
+	}
+
+	app.logger.Warn(
+		"clamping proposal time too far ahead of the local clock",
+		"height", req.Height,
+		"time", req.Time,
+		"max_time", maxTime,
+		"max_skew", app.maxProposalTimeSkew,
+	)
+	return maxTime
+}
+
+// fitProposalTxs checks that the txs returned by the PrepareProposal handler
+// fit in the max tx bytes of the request, as CometBFT refuses to propose them
+// otherwise. Txs exceeding the limit are trimmed from the end of the proposal,
+// see fittingTxsCount, unless rejectOversizedProposals is set, in which case an
+// error is returned.
+func (app *BaseApp) fitProposalTxs(req *abci.RequestPrepareProposal, txs [][]byte) ([][]byte, error) {
+	n := fittingTxsCount(txs, req.MaxTxBytes)
+	if n == len(txs) {
+		return txs, nil
+	}
+
+	if app.rejectOversizedProposals {
+		return nil, fmt.Errorf(
+			"prepared proposal at height %d exceeds max tx bytes %d at tx %d of %d",
+			req.Height, req.MaxTxBytes, n, len(txs),
+		)
+	}
+
+	app.logger.Warn(
+		"trimming prepared proposal exceeding max tx bytes",
+		"height", req.Height,
+		"max_tx_bytes", req.MaxTxBytes,
+		"num_txs", len(txs),
+		"kept_txs", n,
+	)
+
+	return txs[:n], nil
+}
+
 // ProcessProposal implements the ProcessProposal ABCI method and returns a
 // ResponseProcessProposal object to the client. The ProcessProposal method is
 // responsible for allowing execution of application-dependent work in a proposed
@@ -479,6 +693,24 @@ func (app *BaseApp) ProcessProposal(req *abci.RequestProcessProposal) (resp *abc
 		return nil, errors.New("ProcessProposal called with invalid height")
 	}
 
+	if app.validateProposerAddress && len(req.ProposerAddress) != crypto.AddressSize {
+		app.logger.Error(
+			"rejecting proposal with invalid proposer address",
+			"height", req.Height,
+			"proposer", fmt.Sprintf("%X", req.ProposerAddress),
+			"expected_len", crypto.AddressSize,
+			"len", len(req.ProposerAddress),
+		)
+		return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_REJECT}, nil
+	}
+
+	// a zero block time is never set by a correct proposer and would poison
+	// time-dependent module logic
+	if req.Time.IsZero() {
+		app.logger.Error("rejecting proposal with zero block time", "height", req.Height, "hash", fmt.Sprintf("%X", req.Hash))
+		return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_REJECT}, nil
+	}
+
 	// Always reset state given that ProcessProposal can timeout and be called
 	// again in a subsequent round.
 	header := cmtproto.Header{
@@ -487,7 +719,7 @@ func (app *BaseApp) ProcessProposal(req *abci.RequestProcessProposal) (resp *abc
 		Time:               req.Time,
 		ProposerAddress:    req.ProposerAddress,
 		NextValidatorsHash: req.NextValidatorsHash,
-		AppHash:            app.LastCommitID().Hash,
+		AppHash:            app.lastBlockAppHash(),
 	}
 	app.setState(execModeProcessProposal, header)
 
@@ -539,6 +771,16 @@ func (app *BaseApp) ProcessProposal(req *abci.RequestProcessProposal) (resp *abc
 	}()
 
 	resp, err = app.processProposal(app.processProposalState.Context(), req)
+	if rejection := (ProposalRejection{}); errors.As(err, &rejection) {
+		app.logger.Info(
+			"rejected proposal",
+			"height", req.Height,
+			"proposer", fmt.Sprintf("%X", req.ProposerAddress),
+			"hash", fmt.Sprintf("%X", req.Hash),
+			"reason", rejection.Reason,
+		)
+		return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_REJECT}, nil
+	}
 	if err != nil {
 		app.logger.Error("failed to process proposal", "height", req.Height, "time", req.Time, "hash", fmt.Sprintf("%X", req.Hash), "err", err)
 		return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_REJECT}, nil
@@ -553,8 +795,10 @@ func (app *BaseApp) ProcessProposal(req *abci.RequestProcessProposal) (resp *abc
 	// can have a response ready.
 	if resp.Status == abci.ResponseProcessProposal_ACCEPT &&
 		app.optimisticExec.Enabled() &&
-		req.Height > app.initialHeight {
+		req.Height > app.initialHeight &&
+		(app.optimisticExecFilter == nil || app.optimisticExecFilter(req.ProposerAddress)) {
 		app.optimisticExec.Execute(req)
+		emitOptimisticExecMetric("started", req.Height)
 	}
 
 	return resp, nil
@@ -733,7 +977,7 @@ func (app *BaseApp) internalFinalizeBlock(ctx context.Context, req *abci.Request
 		Time:               req.Time,
 		ProposerAddress:    req.ProposerAddress,
 		NextValidatorsHash: req.NextValidatorsHash,
-		AppHash:            app.LastCommitID().Hash,
+		AppHash:            app.lastBlockAppHash(),
 	}
 
 	// finalizeBlockState should be set on InitChain or ProcessProposal. If it is
@@ -752,7 +996,7 @@ func (app *BaseApp) internalFinalizeBlock(ctx context.Context, req *abci.Request
 			Height:  req.Height,
 			Time:    req.Time,
 			Hash:    req.Hash,
-			AppHash: app.LastCommitID().Hash,
+			AppHash: app.lastBlockAppHash(),
 		}).
 		WithConsensusParams(app.GetConsensusParams(app.finalizeBlockState.Context())).
 		WithVoteInfos(req.DecidedLastCommit.Votes).
@@ -764,24 +1008,34 @@ func (app *BaseApp) internalFinalizeBlock(ctx context.Context, req *abci.Request
 			LastCommit:      sdk.ToSDKCommitInfo(req.DecidedLastCommit),
 		}))
 
+	if err := app.validateFinalizeBlockTxCount(app.finalizeBlockState.Context(), req); err != nil {
+		return nil, err
+	}
+
 	// GasMeter must be set after we get a context with updated consensus params.
 	gasMeter := app.getBlockGasMeter(app.finalizeBlockState.Context())
 	app.finalizeBlockState.SetContext(app.finalizeBlockState.Context().WithBlockGasMeter(gasMeter))
 
-	if app.checkState != nil {
-		app.checkState.SetContext(app.checkState.Context().
+	if checkState := app.checkState.Load(); checkState != nil {
+		checkState.SetContext(checkState.Context().
 			WithBlockGasMeter(gasMeter).
 			WithHeaderHash(req.Hash))
 	}
 
+	phaseStart := time.Now()
 	if err := app.preBlock(req); err != nil {
 		return nil, err
 	}
+	preBlockDuration := time.Since(phaseStart)
 
+	phaseStart = time.Now()
+	gasBefore := app.finalizeBlockState.Context().GasMeter().GasConsumed()
 	beginBlock, err := app.beginBlock(req)
 	if err != nil {
 		return nil, err
 	}
+	blockGasUsed := app.finalizeBlockState.Context().GasMeter().GasConsumed() - gasBefore
+	beginBlockDuration := time.Since(phaseStart)
 
 	// First check for an abort signal after beginBlock, as it's the first place
 	// we spend any significant amount of time.
@@ -797,18 +1051,29 @@ func (app *BaseApp) internalFinalizeBlock(ctx context.Context, req *abci.Request
 	// Reset the gas meter so that the AnteHandlers aren't required to
 	gasMeter = app.getBlockGasMeter(app.finalizeBlockState.Context())
 	app.finalizeBlockState.SetContext(app.finalizeBlockState.Context().WithBlockGasMeter(gasMeter))
+	app.recordBlockGasInfo(req.Height, gasMeter, true)
 
 	// Iterate over all raw transactions in the proposal and attempt to execute
 	// them, gathering the execution results.
 	//
 	// NOTE: Not all raw transactions may adhere to the sdk.Tx interface, e.g.
 	// vote extensions, so skip those.
+	//
+	// The abort signal is exposed to the transactions through their context, so
+	// that message handlers honoring it can stop early when an optimistic
+	// execution is aborted. The original context is restored afterwards.
+	baseCtx := app.finalizeBlockState.Context().Context()
+	app.finalizeBlockState.SetContext(app.finalizeBlockState.Context().WithContext(ctx))
+
+	phaseStart = time.Now()
 	txResults := make([]*abci.ExecTxResult, 0, len(req.Txs))
-	for _, rawTx := range req.Txs {
+	for i, rawTx := range req.Txs {
 		var response *abci.ExecTxResult
 
 		if _, err := app.txDecoder(rawTx); err == nil {
 			response = app.deliverTx(rawTx)
+		} else if app.strictFinalizeBlockDecoding {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrTxDecode, "failed to decode tx %d of block %d: %s", i, req.Height, err)
 		} else {
 			// In the case where a transaction included in a block proposal is malformed,
 			// we still want to return a default response to comet. This is because comet
@@ -830,17 +1095,37 @@ func (app *BaseApp) internalFinalizeBlock(ctx context.Context, req *abci.Request
 			// continue
 		}
 
+		app.logger.Debug(
+			"executed tx",
+			"height", req.Height,
+			"tx_index", i,
+			"code", response.Code,
+			"codespace", response.Codespace,
+			"gas_wanted", response.GasWanted,
+			"gas_used", response.GasUsed,
+			"log", truncateTxLog(response.Log),
+		)
+
+		blockGasUsed += uint64(response.GasUsed)
 		txResults = append(txResults, response)
+		app.recordBlockGasInfo(req.Height, gasMeter, true)
 	}
+	txsDuration := time.Since(phaseStart)
+
+	app.finalizeBlockState.SetContext(app.finalizeBlockState.Context().WithContext(baseCtx))
 
 	if app.finalizeBlockState.ms.TracingEnabled() {
 		app.finalizeBlockState.ms = app.finalizeBlockState.ms.SetTracingContext(nil).(storetypes.CacheMultiStore)
 	}
 
+	phaseStart = time.Now()
+	gasBefore = app.finalizeBlockState.Context().GasMeter().GasConsumed()
 	endBlock, err := app.endBlock(app.finalizeBlockState.Context())
 	if err != nil {
 		return nil, err
 	}
+	blockGasUsed += app.finalizeBlockState.Context().GasMeter().GasConsumed() - gasBefore
+	endBlockDuration := time.Since(phaseStart)
 
 	// check after endBlock if we should abort, to avoid propagating the result
 	select {
@@ -852,6 +1137,23 @@ func (app *BaseApp) internalFinalizeBlock(ctx context.Context, req *abci.Request
 
 	events = append(events, endBlock.Events...)
 	cp := app.GetConsensusParams(app.finalizeBlockState.Context())
+	app.recordBlockGasInfo(req.Height, gasMeter, false)
+
+	// report the gas used by the whole block, i.e. by its txs and blockers, to
+	// be correlated with the block time
+	telemetry.SetGauge(float32(blockGasUsed), "block", "gas", "used")
+	telemetry.SetGauge(float32(len(txResults)), "block", "tx", "count")
+
+	if app.blockProfiling {
+		app.pendingBlockProfile = &BlockProfile{
+			Height:     req.Height,
+			NumTxs:     len(req.Txs),
+			PreBlock:   preBlockDuration,
+			BeginBlock: beginBlockDuration,
+			Txs:        txsDuration,
+			EndBlock:   endBlockDuration,
+		}
+	}
 
 	return &abci.ResponseFinalizeBlock{
 		Events:                events,
@@ -861,6 +1163,20 @@ func (app *BaseApp) internalFinalizeBlock(ctx context.Context, req *abci.Request
 	}, nil
 }
 
+// maxLoggedTxLogLength is the maximum length of a tx result log in the per-tx
+// debug logs of FinalizeBlock.
+const maxLoggedTxLogLength = 256
+
+// truncateTxLog caps a tx result log to maxLoggedTxLogLength bytes, so that
+// large logs don't flood the debug logs.
+func truncateTxLog(log string) string {
+	if len(log) <= maxLoggedTxLogLength {
+		return log
+	}
+
+	return log[:maxLoggedTxLogLength] + "...(truncated)"
+}
+
 // FinalizeBlock will execute the block proposal provided by RequestFinalizeBlock.
 // Specifically, it will execute an application's BeginBlock (if defined), followed
 // by the transactions in the proposal, finally followed by the application's
@@ -873,10 +1189,22 @@ func (app *BaseApp) internalFinalizeBlock(ctx context.Context, req *abci.Request
 // where they adhere to the sdk.Tx interface.
 func (app *BaseApp) FinalizeBlock(req *abci.RequestFinalizeBlock) (res *abci.ResponseFinalizeBlock, err error) {
 	defer func() {
+		if len(app.streamingManager.ABCIListeners) == 0 {
+			return
+		}
+
+		// the block may have failed before producing a response or a state, in
+		// which case there is nothing to stream
+		if res == nil || app.finalizeBlockState == nil {
+			app.logger.Info("skipping ListenFinalizeBlock listening hooks; block was not finalized", "height", req.Height, "err", err)
+			return
+		}
+
 		// call the streaming service hooks with the FinalizeBlock messages
 		for _, streamingListener := range app.streamingManager.ABCIListeners {
 			if err := streamingListener.ListenFinalizeBlock(app.finalizeBlockState.Context(), *req, *res); err != nil {
 				app.logger.Error("ListenFinalizeBlock listening hook failed", "height", req.Height, "err", err)
+				app.handleListenerError(fmt.Errorf("ListenFinalizeBlock listening hook failed at height %d: %w", req.Height, err))
 			}
 		}
 	}()
@@ -889,45 +1217,131 @@ func (app *BaseApp) FinalizeBlock(req *abci.RequestFinalizeBlock) (res *abci.Res
 
 		// only return if we are not aborting
 		if !aborted {
+			emitOptimisticExecMetric("useful", req.Height)
 			if res != nil {
+				hashStart := time.Now()
 				res.AppHash = app.workingHash()
+				app.recordBlockProfile(time.Since(hashStart))
+				app.appendAppHashEvent(req.Height, res)
+				app.applyValidatorUpdates(req.Height, res.ValidatorUpdates)
 			}
 
 			return res, err
 		}
 
 		// if it was aborted, we need to reset the state
+		emitOptimisticExecMetric("aborted", req.Height)
 		app.finalizeBlockState = nil
 		app.optimisticExec.Reset()
 	}
 
 	// if no OE is running, just run the block (this is either a block replay or a OE that got aborted)
-	res, err = app.internalFinalizeBlock(context.Background(), req)
+	ctx := context.Background()
+	if app.maxFinalizeBlockDuration > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, app.maxFinalizeBlockDuration)
+		defer cancel()
+	}
+
+	res, err = app.internalFinalizeBlock(ctx, req)
+	if errors.Is(err, context.DeadlineExceeded) {
+		err = fmt.Errorf("finalize block at height %d exceeded max duration %s: %w", req.Height, app.maxFinalizeBlockDuration, err)
+	}
 	if res != nil {
+		hashStart := time.Now()
 		res.AppHash = app.workingHash()
+		app.recordBlockProfile(time.Since(hashStart))
+		app.appendAppHashEvent(req.Height, res)
+		app.applyValidatorUpdates(req.Height, res.ValidatorUpdates)
 	}
 
 	return res, err
 }
 
+// EventTypeAppHash is the type of the FinalizeBlock event carrying the working
+// app hash of the block, see SetAppHashEvent.
+const (
+	EventTypeAppHash = "app_hash"
+
+	AttributeKeyHeight  = "height"
+	AttributeKeyAppHash = "app_hash"
+)
+
+// appendAppHashEvent appends an EventTypeAppHash event with the working app
+// hash of the block to the FinalizeBlock response, if enabled.
+func (app *BaseApp) appendAppHashEvent(height int64, res *abci.ResponseFinalizeBlock) {
+	if !app.appHashEvent {
+		return
+	}
+
+	res.Events = append(res.Events, abci.Event{
+		Type: EventTypeAppHash,
+		Attributes: []abci.EventAttribute{
+			{Key: AttributeKeyHeight, Value: strconv.FormatInt(height, 10), Index: true},
+			{Key: AttributeKeyAppHash, Value: fmt.Sprintf("%X", res.AppHash), Index: true},
+		},
+	})
+}
+
+// emitOptimisticExecMetric counts the optimistic executions that were started,
+// whose result was used by FinalizeBlock or that were aborted because the
+// finalized block differs from the executed proposal.
+func emitOptimisticExecMetric(outcome string, height int64) {
+	telemetry.IncrCounterWithLabels(
+		[]string{"optimistic_execution", outcome},
+		1,
+		[]metrics.Label{telemetry.NewLabel("height", strconv.FormatInt(height, 10))},
+	)
+}
+
 // checkHalt checks if height or time exceeds halt-height or halt-time respectively.
-func (app *BaseApp) checkHalt(height int64, time time.Time) error {
+func (app *BaseApp) checkHalt(height int64, blockTime time.Time) error {
 	var halt bool
 	switch {
 	case app.haltHeight > 0 && uint64(height) > app.haltHeight:
 		halt = true
 
-	case app.haltTime > 0 && time.Unix() > int64(app.haltTime):
+	case app.haltTime > 0 && blockTime.Unix() > int64(app.haltTime):
 		halt = true
 	}
 
+	if app.logHaltEvaluation {
+		app.logger.Debug(
+			"evaluated halt conditions",
+			"height", height,
+			"halt_height", app.haltHeight,
+			"block_time", blockTime.Unix(),
+			"halt_time", app.haltTime,
+			"halt", halt,
+		)
+	}
+
 	if halt {
+		app.waitHaltGracePeriod(height)
+		app.waitForSnapshot()
 		return fmt.Errorf("halt per configuration height %d time %d", app.haltHeight, app.haltTime)
 	}
 
 	return nil
 }
 
+// waitHaltGracePeriod blocks for the configured halt grace period, if any,
+// warning that the node is about to halt.
+func (app *BaseApp) waitHaltGracePeriod(height int64) {
+	if app.haltGracePeriod <= 0 {
+		return
+	}
+
+	app.logger.Warn(
+		"halt conditions reached; node will halt after the grace period",
+		"height", height,
+		"halt_height", app.haltHeight,
+		"halt_time", app.haltTime,
+		"grace_period", app.haltGracePeriod,
+	)
+	app.clock.Sleep(app.haltGracePeriod)
+}
+
 // Commit implements the ABCI interface. It will commit all state that exists in
 // the deliver state's multi-store and includes the resulting commit ID in the
 // returned abci.ResponseCommit. Commit will set the check state based on the
@@ -950,6 +1364,11 @@ func (app *BaseApp) Commit() (*abci.ResponseCommit, error) {
 
 	app.cms.Commit()
 
+	if app.coalesceBeginBlockEvents {
+		app.lastBeginBlockEvents = app.pendingBeginBlockEvents
+		app.pendingBeginBlockEvents = nil
+	}
+
 	resp := &abci.ResponseCommit{
 		RetainHeight: retainHeight,
 	}
@@ -963,6 +1382,7 @@ func (app *BaseApp) Commit() (*abci.ResponseCommit, error) {
 		for _, abciListener := range abciListeners {
 			if err := abciListener.ListenCommit(ctx, *resp, changeSet); err != nil {
 				app.logger.Error("Commit listening hook failed", "height", blockHeight, "err", err)
+				app.handleListenerError(fmt.Errorf("ListenCommit listening hook failed at height %d: %w", blockHeight, err))
 			}
 		}
 	}
@@ -976,11 +1396,11 @@ func (app *BaseApp) Commit() (*abci.ResponseCommit, error) {
 	app.finalizeBlockState = nil
 
 	if app.prepareCheckStater != nil {
-		app.prepareCheckStater(app.checkState.Context())
+		app.prepareCheckStater(app.checkState.Load().Context())
 	}
 
-	// The SnapshotIfApplicable method will create the snapshot by starting the goroutine
-	app.snapshotManager.SnapshotIfApplicable(header.Height)
+	// The snapshotIfApplicable method will create the snapshot by starting the goroutine
+	app.snapshotIfApplicable(header.Height)
 
 	return resp, nil
 }
@@ -1000,16 +1420,60 @@ func (app *BaseApp) workingHash() []byte {
 	commitHash := app.cms.WorkingHash()
 	app.logger.Debug("hash of all writes", "workingHash", fmt.Sprintf("%X", commitHash))
 
+	if app.appHashMixer != nil {
+		commitHash = app.appHashMixer(app.finalizeBlockState.Context(), commitHash)
+		app.logger.Debug("mixed app hash", "appHash", fmt.Sprintf("%X", commitHash))
+		app.mixedAppHash = mixedAppHash{height: app.finalizeBlockState.Context().BlockHeight(), hash: commitHash}
+	}
+
 	return commitHash
 }
 
+// VersionInfo is the JSON encoded response of the /app/version-info query. The
+// last block fields are only set once a block has been committed.
+type VersionInfo struct {
+	Name             string            `json:"name"`
+	Version          string            `json:"version"`
+	AppVersion       uint64            `json:"app_version"`
+	LastBlockHeight  int64             `json:"last_block_height,omitempty"`
+	LastBlockAppHash cmtbytes.HexBytes `json:"last_block_app_hash,omitempty"`
+}
+
 func handleQueryApp(app *BaseApp, path []string, req *abci.RequestQuery) *abci.ResponseQuery {
 	if len(path) >= 2 {
 		switch path[1] {
 		case "simulate":
+			encoding, deterministic := SimulateEncodingJSON, false
+			for _, segment := range path[2:] {
+				if segment == SimulateDeterministic {
+					deterministic = true
+				} else {
+					encoding = segment
+				}
+			}
+			if encoding != SimulateEncodingJSON && encoding != SimulateEncodingProto {
+				return sdkerrors.QueryResult(
+					errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "unknown simulate response encoding %s, expected '%s' or '%s'", encoding, SimulateEncodingJSON, SimulateEncodingProto),
+					app.trace,
+				)
+			}
+
 			txBytes := req.Data
 
-			gInfo, res, err := app.Simulate(txBytes)
+			// reject empty-message txs before running the simulation pipeline,
+			// which would otherwise surface a less descriptive error
+			if app.txDecoder != nil {
+				if tx, err := app.txDecoder(txBytes); err == nil && len(tx.GetMsgs()) == 0 {
+					return sdkerrors.QueryResult(errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "tx contains no messages"), app.trace)
+				}
+			}
+
+			simulate := app.Simulate
+			if deterministic {
+				simulate = app.simulateDeterministic
+			}
+
+			gInfo, res, err := simulate(txBytes)
 			if err != nil {
 				return sdkerrors.QueryResult(errorsmod.Wrap(err, "failed to simulate tx"), app.trace)
 			}
@@ -1019,9 +1483,40 @@ func handleQueryApp(app *BaseApp, path []string, req *abci.RequestQuery) *abci.R
 				Result:  res,
 			}
 
-			bz, err := codec.ProtoMarshalJSON(simRes, app.interfaceRegistry)
+			var bz []byte
+			if encoding == SimulateEncodingProto {
+				bz, err = simRes.Marshal()
+			} else {
+				bz, err = codec.ProtoMarshalJSON(simRes, app.interfaceRegistry)
+			}
+			if err != nil {
+				return sdkerrors.QueryResult(errorsmod.Wrapf(err, "failed to %s encode simulation response", encoding), app.trace)
+			}
+
+			return &abci.ResponseQuery{
+				Codespace: sdkerrors.RootCodespace,
+				Height:    req.Height,
+				Value:     bz,
+				Log:       "encoding=" + encoding,
+				// expose the gas info in the response info so that clients don't
+				// need to decode the whole simulation response to read it
+				Info: encodeQueryInfo(QueryInfo{GasInfo: &gInfo}),
+			}
+
+		case "simulate-batch":
+			var batchReq SimulateBatchRequest
+			if err := json.Unmarshal(req.Data, &batchReq); err != nil {
+				return sdkerrors.QueryResult(errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "failed to JSON decode simulate batch request"), app.trace)
+			}
+
+			batchRes, err := app.simulateBatch(batchReq.Txs)
 			if err != nil {
-				return sdkerrors.QueryResult(errorsmod.Wrap(err, "failed to JSON encode simulation response"), app.trace)
+				return sdkerrors.QueryResult(err, app.trace)
+			}
+
+			bz, err := json.Marshal(batchRes)
+			if err != nil {
+				return sdkerrors.QueryResult(errorsmod.Wrap(err, "failed to JSON encode simulate batch response"), app.trace)
 			}
 
 			return &abci.ResponseQuery{
@@ -1037,6 +1532,233 @@ func handleQueryApp(app *BaseApp, path []string, req *abci.RequestQuery) *abci.R
 				Value:     []byte(app.version),
 			}
 
+		case "version-info":
+			info, err := app.Info(&abci.RequestInfo{})
+			if err != nil {
+				return sdkerrors.QueryResult(errorsmod.Wrap(err, "failed to get version info"), app.trace)
+			}
+
+			versionInfo := VersionInfo{
+				Name:       info.Data,
+				Version:    info.Version,
+				AppVersion: info.AppVersion,
+			}
+			if info.LastBlockHeight > 0 {
+				versionInfo.LastBlockHeight = info.LastBlockHeight
+				versionInfo.LastBlockAppHash = info.LastBlockAppHash
+			}
+
+			bz, err := json.Marshal(versionInfo)
+			if err != nil {
+				return sdkerrors.QueryResult(errorsmod.Wrap(err, "failed to JSON encode version info"), app.trace)
+			}
+
+			return &abci.ResponseQuery{
+				Codespace: sdkerrors.RootCodespace,
+				Height:    req.Height,
+				Value:     bz,
+			}
+
+		case "retention-height":
+			checkState := app.checkState.Load()
+			if checkState == nil {
+				return sdkerrors.QueryResult(errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "retention height is not available before InitChain"), app.trace)
+			}
+
+			info := app.blockRetentionInfo(checkState.Context(), app.LastBlockHeight())
+			bz, err := json.Marshal(info)
+			if err != nil {
+				return sdkerrors.QueryResult(errorsmod.Wrap(err, "failed to JSON encode retention height"), app.trace)
+			}
+
+			return &abci.ResponseQuery{
+				Codespace: sdkerrors.RootCodespace,
+				Height:    req.Height,
+				Value:     bz,
+			}
+
+		case "commit-id":
+			if !app.commitIDQuery {
+				return sdkerrors.QueryResult(errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "unknown query: %s", path), app.trace)
+			}
+
+			bz, err := json.Marshal(app.cms.LastCommitID())
+			if err != nil {
+				return sdkerrors.QueryResult(errorsmod.Wrap(err, "failed to JSON encode commit ID"), app.trace)
+			}
+
+			return &abci.ResponseQuery{
+				Codespace: sdkerrors.RootCodespace,
+				Height:    req.Height,
+				Value:     bz,
+			}
+
+		case "commit-info":
+			cInfo, err := app.LastCommitInfo()
+			if err != nil {
+				return sdkerrors.QueryResult(errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error()), app.trace)
+			}
+
+			bz, err := json.Marshal(cInfo)
+			if err != nil {
+				return sdkerrors.QueryResult(errorsmod.Wrap(err, "failed to JSON encode commit info"), app.trace)
+			}
+
+			return &abci.ResponseQuery{
+				Codespace: sdkerrors.RootCodespace,
+				Height:    req.Height,
+				Value:     bz,
+			}
+
+		case "effective-limits":
+			checkState := app.checkState.Load()
+			if checkState == nil {
+				return sdkerrors.QueryResult(errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "effective limits are not available before InitChain"), app.trace)
+			}
+
+			bz, err := json.Marshal(EffectiveLimits{
+				ConsensusParams: app.GetConsensusParams(checkState.Context()),
+				ModuleLimits:    app.effectiveLimits,
+			})
+			if err != nil {
+				return sdkerrors.QueryResult(errorsmod.Wrap(err, "failed to JSON encode effective limits"), app.trace)
+			}
+
+			return &abci.ResponseQuery{
+				Codespace: sdkerrors.RootCodespace,
+				Height:    req.Height,
+				Value:     bz,
+			}
+
+		case "validator-updates-diff":
+			if app.validatorUpdatesDiffLimit == 0 {
+				return sdkerrors.QueryResult(errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "unknown query: %s", path), app.trace)
+			}
+
+			diff := app.lastValidatorUpdatesDiff.Load()
+			if diff == nil {
+				return sdkerrors.QueryResult(errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "validator updates diff is not available; the validator set is only tracked from InitChain on"), app.trace)
+			}
+
+			bz, err := json.Marshal(diff)
+			if err != nil {
+				return sdkerrors.QueryResult(errorsmod.Wrap(err, "failed to JSON encode validator updates diff"), app.trace)
+			}
+
+			return &abci.ResponseQuery{
+				Codespace: sdkerrors.RootCodespace,
+				Height:    req.Height,
+				Value:     bz,
+			}
+
+		case "consensus-params":
+			if app.paramStore == nil {
+				return sdkerrors.QueryResult(errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "consensus params are not stored by the application"), app.trace)
+			}
+
+			ctx, err := app.CreateQueryContext(req.Height, false)
+			if err != nil {
+				return sdkerrors.QueryResult(errorsmod.Wrapf(err, "consensus params at height %d are not available", req.Height), app.trace)
+			}
+
+			// the block header of query contexts is the latest one, the header
+			// info holds the queried height
+			height := ctx.HeaderInfo().Height
+
+			cp, err := app.paramStore.Get(ctx)
+			if err != nil {
+				return sdkerrors.QueryResult(errorsmod.Wrapf(err, "failed to get consensus params at height %d", height), app.trace)
+			}
+
+			bz, err := json.Marshal(cp)
+			if err != nil {
+				return sdkerrors.QueryResult(errorsmod.Wrap(err, "failed to JSON encode consensus params"), app.trace)
+			}
+
+			return &abci.ResponseQuery{
+				Codespace: sdkerrors.RootCodespace,
+				Height:    height,
+				Value:     bz,
+			}
+
+		case "block-gas":
+			info := app.CurrentBlockGasInfo()
+			if info == nil {
+				return sdkerrors.QueryResult(errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "block gas info is not available before the first block"), app.trace)
+			}
+
+			bz, err := json.Marshal(info)
+			if err != nil {
+				return sdkerrors.QueryResult(errorsmod.Wrap(err, "failed to JSON encode block gas info"), app.trace)
+			}
+
+			return &abci.ResponseQuery{
+				Codespace: sdkerrors.RootCodespace,
+				Height:    req.Height,
+				Value:     bz,
+			}
+
+		case "block-profile":
+			if !app.blockProfiling {
+				return sdkerrors.QueryResult(errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "unknown query: %s", path), app.trace)
+			}
+
+			profile := app.lastBlockProfile.Load()
+			if profile == nil {
+				return sdkerrors.QueryResult(errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "block profile is not available"), app.trace)
+			}
+
+			bz, err := json.Marshal(profile)
+			if err != nil {
+				return sdkerrors.QueryResult(errorsmod.Wrap(err, "failed to JSON encode block profile"), app.trace)
+			}
+
+			return &abci.ResponseQuery{
+				Codespace: sdkerrors.RootCodespace,
+				Height:    req.Height,
+				Value:     bz,
+			}
+
+		case "initial-height":
+			if app.initialHeight == 0 {
+				return sdkerrors.QueryResult(errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "initial height is not available before InitChain"), app.trace)
+			}
+
+			bz, err := json.Marshal(app.InitialHeight())
+			if err != nil {
+				return sdkerrors.QueryResult(errorsmod.Wrap(err, "failed to JSON encode initial height"), app.trace)
+			}
+
+			return &abci.ResponseQuery{
+				Codespace: sdkerrors.RootCodespace,
+				Height:    req.Height,
+				Value:     bz,
+			}
+
+		case "routes":
+			bz, err := json.Marshal(app.grpcQueryRouter.Routes())
+			if err != nil {
+				return sdkerrors.QueryResult(errorsmod.Wrap(err, "failed to JSON encode query routes"), app.trace)
+			}
+
+			return &abci.ResponseQuery{
+				Codespace: sdkerrors.RootCodespace,
+				Height:    req.Height,
+				Value:     bz,
+			}
+
+		case "health":
+			bz, err := json.Marshal(app.health(app.clock.Now()))
+			if err != nil {
+				return sdkerrors.QueryResult(errorsmod.Wrap(err, "failed to JSON encode health"), app.trace)
+			}
+
+			return &abci.ResponseQuery{
+				Codespace: sdkerrors.RootCodespace,
+				Height:    req.Height,
+				Value:     bz,
+			}
+
 		default:
 			return sdkerrors.QueryResult(errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "unknown query: %s", path), app.trace)
 		}
@@ -1045,7 +1767,7 @@ func handleQueryApp(app *BaseApp, path []string, req *abci.RequestQuery) *abci.R
 	return sdkerrors.QueryResult(
 		errorsmod.Wrap(
 			sdkerrors.ErrUnknownRequest,
-			"expected second parameter to be either 'simulate' or 'version', neither was present",
+			"expected second parameter to be one of 'simulate', 'simulate-batch', 'version', 'version-info', 'retention-height', 'commit-id', 'commit-info', 'effective-limits', 'consensus-params', 'validator-updates-diff', 'block-gas', 'block-profile', 'initial-height', 'routes' or 'health', none was present",
 		), app.trace)
 }
 
@@ -1056,8 +1778,24 @@ func handleQueryStore(app *BaseApp, path []string, req abci.RequestQuery) *abci.
 		return sdkerrors.QueryResult(errorsmod.Wrap(sdkerrors.ErrUnknownRequest, "multi-store does not support queries"), app.trace)
 	}
 
+	if len(app.queryableStores) > 0 {
+		if len(path) < 2 {
+			return sdkerrors.QueryResult(errorsmod.Wrap(sdkerrors.ErrUnknownRequest, "no store name provided"), app.trace)
+		}
+
+		if _, ok := app.queryableStores[path[1]]; !ok {
+			return sdkerrors.QueryResult(errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "store %s is not queryable", path[1]), app.trace)
+		}
+	}
+
 	req.Path = "/" + strings.Join(path[1:], "/")
 
+	if req.Height > 0 {
+		if err := app.checkQueryLag(req.Height, app.LastBlockHeight()); err != nil {
+			return sdkerrors.QueryResult(err, app.trace)
+		}
+	}
+
 	if req.Height <= 1 && req.Prove {
 		return sdkerrors.QueryResult(
 			errorsmod.Wrap(
@@ -1067,17 +1805,91 @@ func handleQueryStore(app *BaseApp, path []string, req abci.RequestQuery) *abci.
 	}
 
 	sdkReq := storetypes.RequestQuery(req)
+	start := time.Now()
 	resp, err := queryable.Query(&sdkReq)
+	emitStoreQueryMetric(path, start)
 	if err != nil {
 		return sdkerrors.QueryResult(err, app.trace)
 	}
 	resp.Height = req.Height
 
 	abciResp := abci.ResponseQuery(*resp)
+	if path[len(path)-1] == "subspace" {
+		app.truncateRangeQueryResponse(&abciResp)
+	}
+	app.annotatePruningWarning(&abciResp, req.Height)
 
 	return &abciResp
 }
 
+// emitStoreQueryMetric records the latency of a store query, labeled by the
+// name of the queried store.
+func emitStoreQueryMetric(path []string, start time.Time) {
+	var storeName string
+	if len(path) >= 2 {
+		storeName = path[1]
+	}
+
+	telemetry.MeasureSinceWithLabels(
+		[]string{"query", "store"},
+		start,
+		[]metrics.Label{telemetry.NewLabel("store", storeName)},
+	)
+}
+
+// truncateRangeQueryResponse caps the value of a store range query response to
+// queryMaxResponseBytes. The value is a list of encoded KV pairs, so it is cut
+// at the last pair that fits and the response Info is flagged as truncated to
+// let the client continue from the last returned key.
+func (app *BaseApp) truncateRangeQueryResponse(resp *abci.ResponseQuery) {
+	if app.queryMaxResponseBytes == 0 || uint64(len(resp.Value)) <= app.queryMaxResponseBytes {
+		return
+	}
+
+	var size int
+	for bz := resp.Value; len(bz) > 0; {
+		_, _, n := protowire.ConsumeField(bz)
+		if n < 0 || uint64(size+n) > app.queryMaxResponseBytes {
+			break
+		}
+
+		size += n
+		bz = bz[n:]
+	}
+
+	resp.Value = resp.Value[:size]
+	resp.Info = encodeQueryInfo(QueryInfo{Truncated: true})
+}
+
+// QueryInfo is the JSON encoded Info of the responses of the queries served by
+// BaseApp itself. Each field is only set by the queries it applies to.
+type QueryInfo struct {
+	// Error describes the error of a failed query. It is only set when enabled
+	// with SetQueryErrorInfo.
+	Error *QueryErrorInfo `json:"error,omitempty"`
+	// GasInfo is the gas info of an /app/simulate query.
+	GasInfo *sdk.GasInfo `json:"gas_info,omitempty"`
+	// Truncated is set when the value of a store range query was truncated.
+	Truncated bool `json:"truncated,omitempty"`
+}
+
+// QueryErrorInfo describes the error of a failed query.
+type QueryErrorInfo struct {
+	Code      uint32 `json:"code"`
+	Codespace string `json:"codespace"`
+	Message   string `json:"message"`
+}
+
+func encodeQueryInfo(info QueryInfo) string {
+	bz, err := json.Marshal(info)
+	if err != nil {
+		// cannot happen, all the fields are JSON encodable
+		panic(err)
+	}
+
+	return string(bz)
+}
+
 func handleQueryP2P(app *BaseApp, path []string) *abci.ResponseQuery {
 	// "/p2p" prefix for p2p queries
 	if len(path) < 4 {
@@ -1127,10 +1939,15 @@ func (app *BaseApp) FilterPeerByAddrPort(info string) *abci.ResponseQuery {
 	return &abci.ResponseQuery{}
 }
 
-// FilterPeerByID filters peers by node ID.
+// FilterPeerByID filters peers by node ID. The filters run in the order they
+// were set and the first response with a non-zero code rejects the peer
+// without running the remaining filters. The peer is accepted if no filter
+// rejects it.
 func (app *BaseApp) FilterPeerByID(info string) *abci.ResponseQuery {
-	if app.idPeerFilter != nil {
-		return app.idPeerFilter(info)
+	for _, filter := range app.idPeerFilters {
+		if resp := filter(info); resp != nil && !resp.IsOK() {
+			return resp
+		}
 	}
 
 	return &abci.ResponseQuery{}
@@ -1164,6 +1981,8 @@ func (app *BaseApp) handleQueryGRPC(handler GRPCQueryHandler, req *abci.RequestQ
 		return resp
 	}
 
+	app.annotatePruningWarning(resp, req.Height)
+
 	return resp
 }
 
@@ -1186,6 +2005,18 @@ func gRPCErrorToSDKError(err error) error {
 	case codes.Unauthenticated:
 		return errorsmod.Wrap(sdkerrors.ErrUnauthorized, err.Error())
 
+	case codes.AlreadyExists:
+		return errorsmod.Wrap(sdkerrors.ErrConflict, err.Error())
+
+	case codes.DeadlineExceeded:
+		return errorsmod.Wrap(sdkerrors.ErrTimeout, err.Error())
+
+	case codes.ResourceExhausted:
+		return errorsmod.Wrap(sdkerrors.ErrResourceExhausted, err.Error())
+
+	case codes.Unavailable:
+		return errorsmod.Wrap(sdkerrors.ErrUnavailable, err.Error())
+
 	default:
 		return errorsmod.Wrap(sdkerrors.ErrUnknownRequest, err.Error())
 	}
@@ -1202,6 +2033,10 @@ func checkNegativeHeight(height int64) error {
 // createQueryContext creates a new sdk.Context for a query, taking as args
 // the block height and whether the query needs a proof or not.
 func (app *BaseApp) CreateQueryContext(height int64, prove bool) (sdk.Context, error) {
+	if height == CheckStateQueryHeight && app.checkStateQueries {
+		return app.createCheckStateQueryContext(prove)
+	}
+
 	if err := checkNegativeHeight(height); err != nil {
 		return sdk.Context{}, err
 	}
@@ -1230,6 +2065,10 @@ func (app *BaseApp) CreateQueryContext(height int64, prove bool) (sdk.Context, e
 		height = lastBlockHeight
 	}
 
+	if err := app.checkQueryLag(height, lastBlockHeight); err != nil {
+		return sdk.Context{}, err
+	}
+
 	if height <= 1 && prove {
 		return sdk.Context{},
 			errorsmod.Wrap(
@@ -1256,21 +2095,114 @@ func (app *BaseApp) CreateQueryContext(height int64, prove bool) (sdk.Context, e
 			ChainID: app.chainID,
 			Height:  height,
 		}).
-		WithBlockHeader(app.checkState.Context().BlockHeader())
+		WithBlockHeader(app.checkState.Load().Context().BlockHeader())
 
 	if height != lastBlockHeight {
-		rms, ok := app.cms.(*rootmulti.Store)
-		if ok {
-			cInfo, err := rms.GetCommitInfo(height)
-			if cInfo != nil && err == nil {
-				ctx = ctx.WithHeaderInfo(coreheader.Info{Height: height, Time: cInfo.Timestamp})
-			}
+		if blockTime, ok := app.historicalBlockTime(height); ok {
+			headerInfo := ctx.HeaderInfo()
+			headerInfo.Time = blockTime
+			ctx = ctx.WithHeaderInfo(headerInfo)
 		}
 	}
 
 	return ctx, nil
 }
 
+// createCheckStateQueryContext creates a new sdk.Context for a query against a
+// branch of the check state, which holds the effects of the txs accepted by
+// CheckTx since the last commit.
+func (app *BaseApp) createCheckStateQueryContext(prove bool) (sdk.Context, error) {
+	if prove {
+		return sdk.Context{}, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "cannot query the check state with proof")
+	}
+
+	checkState := app.checkState.Load()
+	if checkState == nil {
+		return sdk.Context{}, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "%s check state is not initialized", app.Name())
+	}
+
+	checkCtx := checkState.Context()
+
+	// branch the check state so that the query cannot alter it
+	return sdk.NewContext(checkState.ms.CacheMultiStore(), true, app.logger).
+		WithMinGasPrices(app.minGasPrices).
+		WithGasMeter(storetypes.NewGasMeter(app.queryGasLimit)).
+		WithBlockHeader(checkCtx.BlockHeader()).
+		WithHeaderInfo(checkCtx.HeaderInfo()), nil
+}
+
+// historicalBlockTime returns the block time of the given committed height as
+// recorded in its commit info. Block times are cached, as the commit info of a
+// committed height never changes.
+func (app *BaseApp) historicalBlockTime(height int64) (time.Time, bool) {
+	if blockTime, ok := app.queryBlockTimes.Get(height); ok {
+		return blockTime.(time.Time), true
+	}
+
+	rms, ok := app.cms.(*rootmulti.Store)
+	if !ok {
+		return time.Time{}, false
+	}
+
+	cInfo, err := rms.GetCommitInfo(height)
+	if cInfo == nil || err != nil {
+		return time.Time{}, false
+	}
+
+	app.queryBlockTimes.Add(height, cInfo.Timestamp)
+	return cInfo.Timestamp, true
+}
+
+// checkQueryLag returns an error if the queried height is more than
+// maxQueryLag blocks behind the last block height.
+func (app *BaseApp) checkQueryLag(height, lastBlockHeight int64) error {
+	if app.maxQueryLag == 0 || lastBlockHeight-height <= int64(app.maxQueryLag) {
+		return nil
+	}
+
+	return errorsmod.Wrapf(
+		sdkerrors.ErrInvalidHeight,
+		"height %d is more than %d blocks behind the latest height; queryable heights are %d to %d",
+		height, app.maxQueryLag, lastBlockHeight-int64(app.maxQueryLag), lastBlockHeight,
+	)
+}
+
+// annotatePruningWarning appends a warning to the response log if the queried
+// height is within queryPruningWarningWindow blocks of the state pruning
+// cutoff, so clients can refetch the data before it is pruned. Queries at a
+// negative height, i.e. CheckStateQueryHeight, are not made against committed
+// state and are never warned about.
+func (app *BaseApp) annotatePruningWarning(resp *abci.ResponseQuery, height int64) {
+	if app.queryPruningWarningWindow == 0 || height < 0 {
+		return
+	}
+
+	opts := app.cms.GetPruning()
+	if opts.GetPruningStrategy() == pruningtypes.PruningNothing {
+		return
+	}
+
+	lastBlockHeight := app.LastBlockHeight()
+	if height == 0 {
+		height = lastBlockHeight
+	}
+
+	cutoff := lastBlockHeight - int64(opts.KeepRecent)
+	if height > cutoff+int64(app.queryPruningWarningWindow) {
+		return
+	}
+
+	warning := fmt.Sprintf(
+		"warning: height %d is within %d blocks of the pruning cutoff (height %d); state at this height may soon be unavailable",
+		height, app.queryPruningWarningWindow, cutoff,
+	)
+	if resp.Log == "" {
+		resp.Log = warning
+	} else {
+		resp.Log = resp.Log + "; " + warning
+	}
+}
+
 // GetBlockRetentionHeight returns the height for which all blocks below this height
 // are pruned from CometBFT. Given a commitment height and a non-zero local
 // minRetainBlocks configuration, the retentionHeight is the smallest height that
@@ -1298,6 +2230,32 @@ func (app *BaseApp) GetBlockRetentionHeight(commitHeight int64) int64 {
 		return 0
 	}
 
+	return app.blockRetentionInfo(app.finalizeBlockState.Context(), commitHeight).RetentionHeight
+}
+
+// BlockRetentionInfo describes how the block retention height returned by
+// GetBlockRetentionHeight is derived for a given commit height. Each constraint
+// is zero when it does not apply.
+type BlockRetentionInfo struct {
+	CommitHeight    int64 `json:"commit_height"`
+	RetentionHeight int64 `json:"retention_height"`
+
+	// EvidenceRetentionHeight is the commit height minus the evidence
+	// MaxAgeNumBlocks consensus parameter.
+	EvidenceRetentionHeight int64 `json:"evidence_retention_height"`
+	// SnapshotRetentionHeight is the commit height minus the number of blocks
+	// required by the snapshot manager to serve its retained snapshots.
+	SnapshotRetentionHeight int64 `json:"snapshot_retention_height"`
+	// MinRetainBlocksRetentionHeight is the commit height minus minRetainBlocks.
+	MinRetainBlocksRetentionHeight int64 `json:"min_retain_blocks_retention_height"`
+}
+
+// blockRetentionInfo computes the block retention height for the given commit
+// height along with the individual constraints it is derived from. The
+// consensus params are read from ctx.
+func (app *BaseApp) blockRetentionInfo(ctx sdk.Context, commitHeight int64) BlockRetentionInfo {
+	info := BlockRetentionInfo{CommitHeight: commitHeight}
+
 	minNonZero := func(x, y int64) int64 {
 		switch {
 		case x == 0:
@@ -1324,27 +2282,54 @@ func (app *BaseApp) GetBlockRetentionHeight(commitHeight int64) int64 {
 	// evidence parameters instead of computing an estimated number of blocks based
 	// on the unbonding period and block commitment time as the two should be
 	// equivalent.
-	cp := app.GetConsensusParams(app.finalizeBlockState.Context())
+	cp := app.GetConsensusParams(ctx)
+	if cp.Evidence == nil {
+		// this runs on every Commit, so only warn once until evidence params
+		// are set
+		warn := app.missingEvidenceParamsWarned.CompareAndSwap(false, true)
+		if app.retainAllBlocksWithoutEvidenceParams {
+			if warn {
+				app.logger.Warn("no evidence consensus params; retaining all blocks", "height", commitHeight)
+			}
+			return info
+		}
+
+		if warn {
+			app.logger.Warn(
+				"no evidence consensus params; block retention ignores the evidence max age",
+				"height", commitHeight,
+			)
+		}
+	} else {
+		app.missingEvidenceParamsWarned.Store(false)
+	}
 	if cp.Evidence != nil && cp.Evidence.MaxAgeNumBlocks > 0 {
-		retentionHeight = commitHeight - cp.Evidence.MaxAgeNumBlocks
+		info.EvidenceRetentionHeight = commitHeight - cp.Evidence.MaxAgeNumBlocks
+		retentionHeight = info.EvidenceRetentionHeight
 	}
 
 	if app.snapshotManager != nil {
 		snapshotRetentionHeights := app.snapshotManager.GetSnapshotBlockRetentionHeights()
 		if snapshotRetentionHeights > 0 {
-			retentionHeight = minNonZero(retentionHeight, commitHeight-snapshotRetentionHeights)
+			info.SnapshotRetentionHeight = commitHeight - snapshotRetentionHeights
+			retentionHeight = minNonZero(retentionHeight, info.SnapshotRetentionHeight)
 		}
 	}
 
-	v := commitHeight - int64(app.minRetainBlocks)
-	retentionHeight = minNonZero(retentionHeight, v)
+	// pruning is disabled if minRetainBlocks is zero
+	if app.minRetainBlocks == 0 {
+		return info
+	}
+
+	info.MinRetainBlocksRetentionHeight = commitHeight - int64(app.minRetainBlocks)
+	retentionHeight = minNonZero(retentionHeight, info.MinRetainBlocksRetentionHeight)
 
-	if retentionHeight <= 0 {
+	if retentionHeight > 0 {
 		// prune nothing in the case of a non-positive height
-		return 0
+		info.RetentionHeight = retentionHeight
 	}
 
-	return retentionHeight
+	return info
 }
 
 // toVoteInfo converts the new ExtendedVoteInfo to VoteInfo.
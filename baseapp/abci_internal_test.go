@@ -0,0 +1,183 @@
+package baseapp
+
+import (
+	"bytes"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtprotocrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/log"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func TestGRPCErrorToSDKError(t *testing.T) {
+	testCases := []struct {
+		name   string
+		err    error
+		expErr *errorsmod.Error
+	}{
+		{"non-status error", errors.New("failure"), sdkerrors.ErrInvalidRequest},
+		{"not found", grpcstatus.Error(codes.NotFound, "failure"), sdkerrors.ErrKeyNotFound},
+		{"invalid argument", grpcstatus.Error(codes.InvalidArgument, "failure"), sdkerrors.ErrInvalidRequest},
+		{"failed precondition", grpcstatus.Error(codes.FailedPrecondition, "failure"), sdkerrors.ErrInvalidRequest},
+		{"unauthenticated", grpcstatus.Error(codes.Unauthenticated, "failure"), sdkerrors.ErrUnauthorized},
+		{"already exists", grpcstatus.Error(codes.AlreadyExists, "failure"), sdkerrors.ErrConflict},
+		{"deadline exceeded", grpcstatus.Error(codes.DeadlineExceeded, "failure"), sdkerrors.ErrTimeout},
+		{"resource exhausted", grpcstatus.Error(codes.ResourceExhausted, "failure"), sdkerrors.ErrResourceExhausted},
+		{"unavailable", grpcstatus.Error(codes.Unavailable, "failure"), sdkerrors.ErrUnavailable},
+		{"other code", grpcstatus.Error(codes.Internal, "failure"), sdkerrors.ErrUnknownRequest},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := gRPCErrorToSDKError(tc.err)
+			require.ErrorIs(t, err, tc.expErr)
+			require.Contains(t, err.Error(), "failure")
+		})
+	}
+}
+
+func TestSetStateCopiesHeader(t *testing.T) {
+	app := NewBaseApp(t.Name(), log.NewNopLogger(), dbm.NewMemDB(), nil)
+	require.NoError(t, app.LoadLatestVersion())
+
+	newHeader := func() cmtproto.Header {
+		return cmtproto.Header{
+			ChainID: "test-chain",
+			Height:  5,
+			LastBlockId: cmtproto.BlockID{
+				Hash:          []byte("last block hash"),
+				PartSetHeader: cmtproto.PartSetHeader{Total: 1, Hash: []byte("part set hash")},
+			},
+			NextValidatorsHash: []byte("next validators hash"),
+			AppHash:            []byte("app hash"),
+			ProposerAddress:    []byte("proposer address"),
+		}
+	}
+
+	h := newHeader()
+	app.setState(execModeFinalize, h)
+	app.setState(execModeCheck, h)
+
+	// mutate the byte slices shared with the caller
+	for _, bz := range [][]byte{
+		h.LastBlockId.Hash, h.LastBlockId.PartSetHeader.Hash, h.NextValidatorsHash, h.AppHash, h.ProposerAddress,
+	} {
+		bz[0] = 'X'
+	}
+
+	expected := newHeader()
+	for _, st := range []*state{app.finalizeBlockState, app.checkState.Load()} {
+		ctx := st.Context()
+		require.Equal(t, expected, ctx.BlockHeader())
+		require.Equal(t, expected.AppHash, ctx.HeaderInfo().AppHash)
+	}
+
+	// states do not share their headers either
+	app.finalizeBlockState.Context().BlockHeader().AppHash[0] = 'Y'
+	require.Equal(t, expected.AppHash, app.checkState.Load().Context().BlockHeader().AppHash)
+}
+
+func TestLatestCheckStateInfoConcurrency(t *testing.T) {
+	app := NewBaseApp(t.Name(), log.NewNopLogger(), dbm.NewMemDB(), nil)
+	require.NoError(t, app.LoadLatestVersion())
+
+	_, ok := app.LatestCheckStateInfo()
+	require.True(t, ok)
+
+	const heights = 200
+	done := make(chan struct{})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				select {
+				case <-done:
+					return
+				default:
+				}
+
+				info, ok := app.LatestCheckStateInfo()
+				require.True(t, ok)
+				// the height and time are always read from the same context
+				require.Equal(t, info.Height, info.Time.Unix())
+			}
+		}()
+	}
+
+	for height := int64(1); height <= heights; height++ {
+		blockTime := time.Unix(height, 0)
+		if height%2 == 0 {
+			app.setState(execModeCheck, cmtproto.Header{ChainID: "test-chain", Height: height, Time: blockTime})
+			continue
+		}
+
+		ctx := app.checkState.Load().Context()
+		headerInfo := ctx.HeaderInfo()
+		headerInfo.Height, headerInfo.Time = height, blockTime
+		app.checkState.Load().SetContext(ctx.WithHeaderInfo(headerInfo))
+	}
+	close(done)
+	wg.Wait()
+
+	info, ok := app.LatestCheckStateInfo()
+	require.True(t, ok)
+	require.Equal(t, StateInfo{Height: heights, Time: time.Unix(heights, 0).UTC(), ChainID: "test-chain"}, info)
+}
+
+func TestValidateGenesisValidatorsOrder(t *testing.T) {
+	val := func(key byte, power int64) abci.ValidatorUpdate {
+		return abci.ValidatorUpdate{
+			PubKey: cmtprotocrypto.PublicKey{Sum: &cmtprotocrypto.PublicKey_Ed25519{Ed25519: bytes.Repeat([]byte{key}, 32)}},
+			Power:  power,
+		}
+	}
+
+	testCases := []struct {
+		name             string
+		reqVals, resVals []abci.ValidatorUpdate
+		expErr           bool
+	}{
+		{
+			name:    "equal power validators in different orders",
+			reqVals: []abci.ValidatorUpdate{val(1, 10), val(2, 10), val(3, 10)},
+			resVals: []abci.ValidatorUpdate{val(3, 10), val(1, 10), val(2, 10)},
+		},
+		{
+			name:    "same public key with different powers in different orders",
+			reqVals: []abci.ValidatorUpdate{val(1, 10), val(1, 20), val(2, 10)},
+			resVals: []abci.ValidatorUpdate{val(2, 10), val(1, 20), val(1, 10)},
+		},
+		{
+			name:    "different powers",
+			reqVals: []abci.ValidatorUpdate{val(1, 10), val(2, 10)},
+			resVals: []abci.ValidatorUpdate{val(2, 10), val(1, 20)},
+			expErr:  true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateGenesisValidators(tc.reqVals, tc.resVals)
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -6,11 +6,14 @@ import (
 	"crypto/sha256"
 	"encoding/binary"
 	"encoding/hex"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"math/rand"
+	"slices"
 	"strconv"
 	"strings"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -24,7 +27,9 @@ import (
 	"github.com/cosmos/gogoproto/jsonpb"
 	"github.com/cosmos/gogoproto/proto"
 	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/go-metrics"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
 
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/log"
@@ -74,7 +79,7 @@ func TestABCI_Info(t *testing.T) {
 	require.NoError(t, err)
 	_, err = suite.baseApp.Commit()
 	require.NoError(t, err)
-	require.NoError(t, suite.baseApp.SetAppVersion(ctx, 1))
+	require.NoError(t, suite.baseApp.SetAppVersion(suite.baseApp.NewUncachedContext(false, cmtproto.Header{}), 1))
 	res, err = suite.baseApp.Info(&reqInfo)
 	require.NoError(t, err)
 	require.Equal(t, uint64(1), res.AppVersion)
@@ -198,6 +203,423 @@ func TestABCI_InitChain(t *testing.T) {
 	require.Equal(t, value, resQ.Value)
 }
 
+func TestABCI_InitChain_AppHash(t *testing.T) {
+	db := dbm.NewMemDB()
+	capKey := storetypes.NewKVStoreKey("main")
+	initChainer := func(ctx sdk.Context, req *abci.RequestInitChain) (*abci.ResponseInitChain, error) {
+		ctx.KVStore(capKey).Set([]byte("key"), []byte("value"))
+		return &abci.ResponseInitChain{}, nil
+	}
+	newApp := func() *baseapp.BaseApp {
+		app := baseapp.NewBaseApp(t.Name(), log.NewTestLogger(t), db, nil, baseapp.SetChainID("test-chain-id"))
+		app.SetInitChainer(initChainer)
+		app.MountStores(capKey)
+		require.NoError(t, app.LoadLatestVersion())
+		return app
+	}
+	emptyHash := sha256.Sum256([]byte{})
+
+	// a new chain reports the hash of the empty string
+	app := newApp()
+	res, err := app.InitChain(&abci.RequestInitChain{ChainId: "test-chain-id"})
+	require.NoError(t, err)
+	require.Equal(t, emptyHash[:], res.AppHash)
+
+	_, err = app.FinalizeBlock(&abci.RequestFinalizeBlock{Height: 1})
+	require.NoError(t, err)
+	_, err = app.Commit()
+	require.NoError(t, err)
+
+	// a chain initialized on top of committed state reports the last app hash
+	app = newApp()
+	lastCommitID := app.LastCommitID()
+	require.False(t, lastCommitID.IsZero())
+	res, err = app.InitChain(&abci.RequestInitChain{ChainId: "test-chain-id"})
+	require.NoError(t, err)
+	require.Equal(t, lastCommitID.Hash, res.AppHash)
+	require.NotEqual(t, emptyHash[:], res.AppHash)
+}
+
+// initialVersionErrorStore is a CommitMultiStore failing to set an initial version.
+type initialVersionErrorStore struct {
+	storetypes.CommitMultiStore
+}
+
+func (initialVersionErrorStore) SetInitialVersion(int64) error {
+	return errors.New("initial version error")
+}
+
+func TestABCI_InitChain_SetInitialVersionError(t *testing.T) {
+	db := dbm.NewMemDB()
+	app := baseapp.NewBaseApp(t.Name(), log.NewTestLogger(t), db, nil, baseapp.SetChainID("test-chain-id"))
+	app.SetCMS(initialVersionErrorStore{app.CommitMultiStore()})
+
+	res, err := app.InitChain(&abci.RequestInitChain{ChainId: "test-chain-id", InitialHeight: 3})
+	require.Nil(t, res)
+	require.EqualError(t, err, "failed to set initial version 3 for chain test-chain-id: initial version error")
+}
+
+func TestABCI_InitChain_DefaultConsensusParams(t *testing.T) {
+	suite := NewBaseAppSuite(t, baseapp.SetInitChainDefaultConsensusParams(true))
+
+	_, err := suite.baseApp.InitChain(&abci.RequestInitChain{})
+	require.NoError(t, err)
+
+	cp := suite.baseApp.GetConsensusParams(suite.baseApp.NewContext(true))
+	require.Equal(t, baseapp.DefaultConsensusParams(), cp)
+	require.Equal(t, int64(22020096), cp.Block.MaxBytes)
+	require.Equal(t, int64(-1), cp.Block.MaxGas)
+	require.Equal(t, int64(100000), cp.Evidence.MaxAgeNumBlocks)
+	require.Equal(t, []string{cmttypes.ABCIPubKeyTypeEd25519}, cp.Validator.PubKeyTypes)
+
+	// the params of the request take precedence over the defaults
+	suite = NewBaseAppSuite(t, baseapp.SetInitChainDefaultConsensusParams(true))
+	_, err = suite.baseApp.InitChain(&abci.RequestInitChain{
+		ConsensusParams: &cmtproto.ConsensusParams{Block: &cmtproto.BlockParams{MaxGas: 1000}},
+	})
+	require.NoError(t, err)
+	cp = suite.baseApp.GetConsensusParams(suite.baseApp.NewContext(true))
+	require.Equal(t, int64(1000), cp.Block.MaxGas)
+	require.Nil(t, cp.Evidence)
+}
+
+func TestABCI_InitChain_ValidatorsMismatch(t *testing.T) {
+	pubKey := func(b byte) cmtprotocrypto.PublicKey {
+		return cmtprotocrypto.PublicKey{
+			Sum: &cmtprotocrypto.PublicKey_Ed25519{Ed25519: bytes.Repeat([]byte{b}, 32)},
+		}
+	}
+	initChainer := func(ctx sdk.Context, req *abci.RequestInitChain) (*abci.ResponseInitChain, error) {
+		return &abci.ResponseInitChain{
+			Validators: []abci.ValidatorUpdate{{PubKey: pubKey(1), Power: 20}},
+		}, nil
+	}
+	newReq := func() *abci.RequestInitChain {
+		return &abci.RequestInitChain{
+			ChainId:    "test-chain-id",
+			Validators: []abci.ValidatorUpdate{{PubKey: pubKey(1), Power: 10}},
+		}
+	}
+
+	// strict mode reports the mismatching validators
+	app := baseapp.NewBaseApp(t.Name(), log.NewTestLogger(t), dbm.NewMemDB(), nil, baseapp.SetChainID("test-chain-id"))
+	app.SetInitChainer(initChainer)
+	_, err := app.InitChain(newReq())
+	require.ErrorContains(t, err, "genesisValidators[0] != req.Validators[0]")
+	require.ErrorContains(t, err, "power: 20")
+	require.ErrorContains(t, err, "power: 10")
+
+	// lenient mode only logs the mismatch
+	app = baseapp.NewBaseApp(
+		t.Name(), log.NewTestLogger(t), dbm.NewMemDB(), nil,
+		baseapp.SetChainID("test-chain-id"),
+		baseapp.SetAllowGenesisValidatorsMismatch(true),
+	)
+	app.SetInitChainer(initChainer)
+	_, err = app.InitChain(newReq())
+	require.NoError(t, err)
+}
+
+func TestABCI_FinalizeBlock_AppHashMixer(t *testing.T) {
+	mix := func(height int64, storeHash []byte) []byte {
+		h := sha256.New()
+		h.Write([]byte(fmt.Sprintf("da-commitment-%d", height)))
+		h.Write(storeHash)
+		return h.Sum(nil)
+	}
+	mixerOpt := baseapp.SetAppHashMixer(func(ctx sdk.Context, storeHash []byte) []byte {
+		return mix(ctx.BlockHeight(), storeHash)
+	})
+
+	// headerAppHashes records the app hash of the block header seen by
+	// ProcessProposal and BeginBlock, by height
+	var headerAppHashes map[string][]byte
+	headerOpt := func(bapp *baseapp.BaseApp) {
+		bapp.SetProcessProposal(func(ctx sdk.Context, req *abci.RequestProcessProposal) (*abci.ResponseProcessProposal, error) {
+			headerAppHashes[fmt.Sprintf("process-%d", req.Height)] = ctx.BlockHeader().AppHash
+			return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_ACCEPT}, nil
+		})
+		bapp.SetBeginBlocker(func(ctx sdk.Context) (sdk.BeginBlock, error) {
+			headerAppHashes[fmt.Sprintf("begin-%d", ctx.BlockHeight())] = ctx.BlockHeader().AppHash
+			return sdk.BeginBlock{}, nil
+		})
+	}
+
+	for _, withMixer := range []bool{false, true} {
+		headerAppHashes = map[string][]byte{}
+		opts := []func(*baseapp.BaseApp){headerOpt}
+		if withMixer {
+			opts = append(opts, mixerOpt)
+		}
+
+		suite := NewBaseAppSuite(t, opts...)
+		_, err := suite.baseApp.InitChain(&abci.RequestInitChain{
+			ConsensusParams: &cmtproto.ConsensusParams{},
+		})
+		require.NoError(t, err)
+
+		var lastAppHash []byte
+		for height := int64(1); height <= 2; height++ {
+			_, err := suite.baseApp.ProcessProposal(&abci.RequestProcessProposal{Height: height, Time: time.Now()})
+			require.NoError(t, err)
+			res, err := suite.baseApp.FinalizeBlock(&abci.RequestFinalizeBlock{Height: height})
+			require.NoError(t, err)
+			_, err = suite.baseApp.Commit()
+			require.NoError(t, err)
+
+			// the multistore keeps committing the raw store hash
+			storeHash := suite.baseApp.LastCommitID().Hash
+			expected := storeHash
+			if withMixer {
+				expected = mix(height, storeHash)
+				require.NotEqual(t, storeHash, res.AppHash)
+			}
+			require.Equal(t, expected, res.AppHash)
+
+			// Info reports the same app hash as FinalizeBlock
+			info, err := suite.baseApp.Info(&abci.RequestInfo{})
+			require.NoError(t, err)
+			require.Equal(t, height, info.LastBlockHeight)
+			require.Equal(t, res.AppHash, info.LastBlockAppHash)
+
+			// block headers carry the app hash of the previous block
+			if height > 1 {
+				require.Equal(t, lastAppHash, headerAppHashes[fmt.Sprintf("process-%d", height)])
+				require.Equal(t, lastAppHash, headerAppHashes[fmt.Sprintf("begin-%d", height)])
+
+				queryCtx, err := suite.baseApp.CreateQueryContext(0, false)
+				require.NoError(t, err)
+				require.Equal(t, lastAppHash, queryCtx.BlockHeader().AppHash)
+			}
+			lastAppHash = res.AppHash
+		}
+	}
+
+	// after a restart, the mixed app hash is derived again from the last
+	// committed state
+	db, paramsDB := dbm.NewMemDB(), dbm.NewMemDB()
+	newApp := func() *baseapp.BaseApp {
+		app := baseapp.NewBaseApp(t.Name(), log.NewTestLogger(t), db, nil, mixerOpt, headerOpt)
+		app.SetParamStore(&paramStore{db: paramsDB})
+		require.NoError(t, app.LoadLatestVersion())
+		return app
+	}
+	headerAppHashes = map[string][]byte{}
+
+	app := newApp()
+	_, err := app.InitChain(&abci.RequestInitChain{ConsensusParams: &cmtproto.ConsensusParams{}})
+	require.NoError(t, err)
+	res, err := app.FinalizeBlock(&abci.RequestFinalizeBlock{Height: 1})
+	require.NoError(t, err)
+	_, err = app.Commit()
+	require.NoError(t, err)
+
+	app = newApp()
+	info, err := app.Info(&abci.RequestInfo{})
+	require.NoError(t, err)
+	require.Equal(t, res.AppHash, info.LastBlockAppHash)
+	_, err = app.ProcessProposal(&abci.RequestProcessProposal{Height: 2, Time: time.Now()})
+	require.NoError(t, err)
+	require.Equal(t, res.AppHash, headerAppHashes["process-2"])
+}
+
+func TestABCI_BlockProfile(t *testing.T) {
+	phaseDuration := time.Millisecond
+	blockersOpt := func(bapp *baseapp.BaseApp) {
+		bapp.SetPreBlocker(func(ctx sdk.Context, req *abci.RequestFinalizeBlock) (*sdk.ResponsePreBlock, error) {
+			time.Sleep(phaseDuration)
+			return &sdk.ResponsePreBlock{}, nil
+		})
+		bapp.SetBeginBlocker(func(ctx sdk.Context) (sdk.BeginBlock, error) {
+			time.Sleep(phaseDuration)
+			return sdk.BeginBlock{}, nil
+		})
+		bapp.SetEndBlocker(func(ctx sdk.Context) (sdk.EndBlock, error) {
+			time.Sleep(phaseDuration)
+			return sdk.EndBlock{}, nil
+		})
+	}
+	profileQuery := &abci.RequestQuery{Path: "/app/block-profile"}
+
+	// profiling is disabled by default
+	suite := NewBaseAppSuite(t, blockersOpt)
+	res, err := suite.baseApp.Query(context.TODO(), profileQuery)
+	require.NoError(t, err)
+	require.Equal(t, sdkerrors.ErrUnknownRequest.ABCICode(), res.Code)
+
+	suite = NewBaseAppSuite(t, blockersOpt, baseapp.SetBlockProfiling(true))
+	baseapptestutil.RegisterCounterServer(suite.baseApp.MsgServiceRouter(), CounterServerImplGasMeterOnly{})
+
+	_, err = suite.baseApp.InitChain(&abci.RequestInitChain{
+		ConsensusParams: &cmtproto.ConsensusParams{},
+	})
+	require.NoError(t, err)
+	require.Nil(t, suite.baseApp.LastBlockProfile())
+
+	res, err = suite.baseApp.Query(context.TODO(), profileQuery)
+	require.NoError(t, err)
+	require.Equal(t, sdkerrors.ErrInvalidRequest.ABCICode(), res.Code)
+
+	txBytes, err := suite.txConfig.TxEncoder()(newTxCounter(t, suite.txConfig, 0, 0))
+	require.NoError(t, err)
+
+	_, err = suite.baseApp.FinalizeBlock(&abci.RequestFinalizeBlock{
+		Height: 1,
+		Txs:    [][]byte{txBytes},
+	})
+	require.NoError(t, err)
+	_, err = suite.baseApp.Commit()
+	require.NoError(t, err)
+
+	profile := suite.baseApp.LastBlockProfile()
+	require.NotNil(t, profile)
+	require.Equal(t, int64(1), profile.Height)
+	require.Equal(t, 1, profile.NumTxs)
+	require.GreaterOrEqual(t, profile.PreBlock, phaseDuration)
+	require.GreaterOrEqual(t, profile.BeginBlock, phaseDuration)
+	require.GreaterOrEqual(t, profile.EndBlock, phaseDuration)
+	require.Positive(t, profile.Txs)
+	require.Positive(t, profile.WorkingHash)
+	require.Contains(t, suite.logBuffer.String(), "block profile")
+
+	res, err = suite.baseApp.Query(context.TODO(), profileQuery)
+	require.NoError(t, err)
+	require.True(t, res.IsOK(), res.Log)
+
+	var queried baseapp.BlockProfile
+	require.NoError(t, json.Unmarshal(res.Value, &queried))
+	require.Equal(t, *profile, queried)
+}
+
+func TestABCI_Query_Health(t *testing.T) {
+	suite := NewBaseAppSuite(t, baseapp.SetHealthMaxBlockAge(time.Hour))
+
+	queryHealth := func() baseapp.Health {
+		res, err := suite.baseApp.Query(context.TODO(), &abci.RequestQuery{Path: "/app/health"})
+		require.NoError(t, err)
+		require.True(t, res.IsOK(), res.Log)
+
+		var health baseapp.Health
+		require.NoError(t, json.Unmarshal(res.Value, &health))
+		return health
+	}
+
+	// no block was committed yet
+	require.Equal(t, baseapp.Health{}, queryHealth())
+
+	_, err := suite.baseApp.InitChain(&abci.RequestInitChain{
+		ConsensusParams: &cmtproto.ConsensusParams{},
+	})
+	require.NoError(t, err)
+
+	// a block older than the max block age is not considered recent activity
+	now := time.Now().UTC()
+	for height, blockTime := range []time.Time{now.Add(-2 * time.Hour), now} {
+		_, err = suite.baseApp.FinalizeBlock(&abci.RequestFinalizeBlock{Height: int64(height + 1), Time: blockTime})
+		require.NoError(t, err)
+		_, err = suite.baseApp.Commit()
+		require.NoError(t, err)
+
+		health := queryHealth()
+		require.Equal(t, suite.baseApp.LastBlockHeight(), health.LastBlockHeight)
+		require.Equal(t, int64(height+1), health.LastBlockHeight)
+		require.True(t, blockTime.Equal(health.LastBlockTime))
+		require.Equal(t, height == 1, health.Active)
+	}
+}
+
+func TestABCI_ValidatorUpdatesDiff(t *testing.T) {
+	pubKey := func(b byte) cmtprotocrypto.PublicKey {
+		return cmtprotocrypto.PublicKey{
+			Sum: &cmtprotocrypto.PublicKey_Ed25519{Ed25519: bytes.Repeat([]byte{b}, 32)},
+		}
+	}
+	change := func(b byte, oldPower, power int64) baseapp.ValidatorPowerChange {
+		return baseapp.ValidatorPowerChange{
+			PubKeyType: "ed25519",
+			PubKey:     bytes.Repeat([]byte{b}, 32),
+			OldPower:   oldPower,
+			Power:      power,
+		}
+	}
+
+	var updates []abci.ValidatorUpdate
+	endBlockerOpt := func(bapp *baseapp.BaseApp) {
+		bapp.SetEndBlocker(func(ctx sdk.Context) (sdk.EndBlock, error) {
+			return sdk.EndBlock{ValidatorUpdates: updates}, nil
+		})
+	}
+	diffQuery := &abci.RequestQuery{Path: "/app/validator-updates-diff"}
+
+	// the query is disabled by default
+	suite := NewBaseAppSuite(t, endBlockerOpt)
+	res, err := suite.baseApp.Query(context.TODO(), diffQuery)
+	require.NoError(t, err)
+	require.Equal(t, sdkerrors.ErrUnknownRequest.ABCICode(), res.Code)
+
+	suite = NewBaseAppSuite(t, endBlockerOpt, baseapp.SetValidatorUpdatesDiffLimit(2))
+	_, err = suite.baseApp.InitChain(&abci.RequestInitChain{
+		ConsensusParams: &cmtproto.ConsensusParams{},
+		Validators:      []abci.ValidatorUpdate{{PubKey: pubKey(1), Power: 10}},
+	})
+	require.NoError(t, err)
+
+	finalizeBlock := func(height int64, blockUpdates ...abci.ValidatorUpdate) *abci.ResponseQuery {
+		updates = blockUpdates
+		_, err := suite.baseApp.FinalizeBlock(&abci.RequestFinalizeBlock{Height: height})
+		require.NoError(t, err)
+		_, err = suite.baseApp.Commit()
+		require.NoError(t, err)
+
+		res, err := suite.baseApp.Query(context.TODO(), diffQuery)
+		require.NoError(t, err)
+		return res
+	}
+	requireDiff := func(res *abci.ResponseQuery, expected baseapp.ValidatorUpdatesDiff) {
+		require.True(t, res.IsOK(), res.Log)
+
+		var diff baseapp.ValidatorUpdatesDiff
+		require.NoError(t, json.Unmarshal(res.Value, &diff))
+		require.Equal(t, expected, diff)
+	}
+
+	// a power change of a genesis validator
+	res = finalizeBlock(1, abci.ValidatorUpdate{PubKey: pubKey(1), Power: 15})
+	requireDiff(res, baseapp.ValidatorUpdatesDiff{
+		Height:  1,
+		Updated: []baseapp.ValidatorPowerChange{change(1, 10, 15)},
+	})
+
+	// a validator replacing another one
+	res = finalizeBlock(2,
+		abci.ValidatorUpdate{PubKey: pubKey(2), Power: 5},
+		abci.ValidatorUpdate{PubKey: pubKey(1), Power: 0},
+	)
+	requireDiff(res, baseapp.ValidatorUpdatesDiff{
+		Height:  2,
+		Added:   []baseapp.ValidatorPowerChange{change(2, 0, 5)},
+		Removed: []baseapp.ValidatorPowerChange{change(1, 15, 0)},
+	})
+
+	// unchanged powers are not reported
+	res = finalizeBlock(3, abci.ValidatorUpdate{PubKey: pubKey(2), Power: 5})
+	requireDiff(res, baseapp.ValidatorUpdatesDiff{Height: 3})
+
+	// tracking stops once the set grows past the limit
+	res = finalizeBlock(4,
+		abci.ValidatorUpdate{PubKey: pubKey(3), Power: 5},
+		abci.ValidatorUpdate{PubKey: pubKey(4), Power: 5},
+	)
+	require.Equal(t, sdkerrors.ErrInvalidRequest.ABCICode(), res.Code)
+
+	// without InitChain, e.g. after a restart, the validator set is unknown and
+	// no diff is reported
+	suite = NewBaseAppSuite(t, endBlockerOpt, baseapp.SetValidatorUpdatesDiffLimit(2))
+	res = finalizeBlock(1, abci.ValidatorUpdate{PubKey: pubKey(1), Power: 15})
+	require.Equal(t, sdkerrors.ErrInvalidRequest.ABCICode(), res.Code)
+	require.Contains(t, res.Log, "validator updates diff is not available")
+}
+
 func TestABCI_InitChain_WithInitialHeight(t *testing.T) {
 	name := t.Name()
 	db := dbm.NewMemDB()
@@ -215,6 +637,48 @@ func TestABCI_InitChain_WithInitialHeight(t *testing.T) {
 	require.Equal(t, int64(3), app.LastBlockHeight())
 }
 
+func TestABCI_InitChain_InitialHeightRestart(t *testing.T) {
+	db := dbm.NewMemDB()
+	newApp := func() *baseapp.BaseApp {
+		app := baseapp.NewBaseApp(t.Name(), log.NewTestLogger(t), db, nil)
+		require.NoError(t, app.LoadLatestVersion())
+		return app
+	}
+	queryInitialHeight := func(app *baseapp.BaseApp) *abci.ResponseQuery {
+		res, err := app.Query(context.TODO(), &abci.RequestQuery{Path: "/app/initial-height"})
+		require.NoError(t, err)
+		return res
+	}
+
+	app := newApp()
+	require.Equal(t, int64(0), app.InitialHeight())
+	res := queryInitialHeight(app)
+	require.False(t, res.IsOK())
+	require.Contains(t, res.Log, "initial height is not available before InitChain")
+
+	_, err := app.InitChain(&abci.RequestInitChain{InitialHeight: 3})
+	require.NoError(t, err)
+	require.Equal(t, int64(3), app.InitialHeight())
+
+	_, err = app.FinalizeBlock(&abci.RequestFinalizeBlock{Height: 3})
+	require.NoError(t, err)
+	_, err = app.Commit()
+	require.NoError(t, err)
+
+	// the initial height is restored on restart
+	app = newApp()
+	require.Equal(t, int64(3), app.InitialHeight())
+	res = queryInitialHeight(app)
+	require.True(t, res.IsOK(), res.Log)
+	require.Equal(t, []byte("3"), res.Value)
+
+	// an initial height of zero defaults to one
+	app = baseapp.NewBaseApp(t.Name(), log.NewTestLogger(t), dbm.NewMemDB(), nil)
+	_, err = app.InitChain(&abci.RequestInitChain{})
+	require.NoError(t, err)
+	require.Equal(t, int64(1), app.InitialHeight())
+}
+
 func TestABCI_FinalizeBlock_WithInitialHeight(t *testing.T) {
 	name := t.Name()
 	db := dbm.NewMemDB()
@@ -237,6 +701,111 @@ func TestABCI_FinalizeBlock_WithInitialHeight(t *testing.T) {
 	require.Equal(t, int64(3), app.LastBlockHeight())
 }
 
+func TestABCI_ValidateFinalizeBlockRequest(t *testing.T) {
+	suite := NewBaseAppSuite(t)
+
+	_, err := suite.baseApp.InitChain(&abci.RequestInitChain{
+		ConsensusParams: &cmtproto.ConsensusParams{},
+	})
+	require.NoError(t, err)
+	_, err = suite.baseApp.FinalizeBlock(&abci.RequestFinalizeBlock{Height: 1})
+	require.NoError(t, err)
+	_, err = suite.baseApp.Commit()
+	require.NoError(t, err)
+
+	addr := bytes.Repeat([]byte{1}, 20)
+	validReq := func() *abci.RequestFinalizeBlock {
+		return &abci.RequestFinalizeBlock{
+			Height:          2,
+			ProposerAddress: addr,
+			Txs:             [][]byte{{1}},
+			DecidedLastCommit: abci.CommitInfo{
+				Votes: []abci.VoteInfo{{Validator: abci.Validator{Address: addr, Power: 10}}},
+			},
+			Misbehavior: []abci.Misbehavior{{Validator: abci.Validator{Address: addr, Power: 10}, Height: 1}},
+		}
+	}
+
+	testCases := map[string]struct {
+		malleate func(req *abci.RequestFinalizeBlock) *abci.RequestFinalizeBlock
+		expErr   error
+	}{
+		"valid": {
+			func(req *abci.RequestFinalizeBlock) *abci.RequestFinalizeBlock { return req },
+			nil,
+		},
+		"nil request": {
+			func(req *abci.RequestFinalizeBlock) *abci.RequestFinalizeBlock { return nil },
+			sdkerrors.ErrInvalidRequest,
+		},
+		"unexpected height": {
+			func(req *abci.RequestFinalizeBlock) *abci.RequestFinalizeBlock { req.Height = 3; return req },
+			sdkerrors.ErrInvalidHeight,
+		},
+		"malformed proposer address": {
+			func(req *abci.RequestFinalizeBlock) *abci.RequestFinalizeBlock {
+				req.ProposerAddress = []byte{1}
+				return req
+			},
+			sdkerrors.ErrInvalidRequest,
+		},
+		"negative commit round": {
+			func(req *abci.RequestFinalizeBlock) *abci.RequestFinalizeBlock {
+				req.DecidedLastCommit.Round = -1
+				return req
+			},
+			sdkerrors.ErrInvalidRequest,
+		},
+		"malformed vote address": {
+			func(req *abci.RequestFinalizeBlock) *abci.RequestFinalizeBlock {
+				req.DecidedLastCommit.Votes[0].Validator.Address = []byte{1}
+				return req
+			},
+			sdkerrors.ErrInvalidRequest,
+		},
+		"negative vote power": {
+			func(req *abci.RequestFinalizeBlock) *abci.RequestFinalizeBlock {
+				req.DecidedLastCommit.Votes[0].Validator.Power = -1
+				return req
+			},
+			sdkerrors.ErrInvalidRequest,
+		},
+		"malformed misbehavior address": {
+			func(req *abci.RequestFinalizeBlock) *abci.RequestFinalizeBlock {
+				req.Misbehavior[0].Validator.Address = nil
+				return req
+			},
+			sdkerrors.ErrInvalidRequest,
+		},
+		"misbehavior from the future": {
+			func(req *abci.RequestFinalizeBlock) *abci.RequestFinalizeBlock {
+				req.Misbehavior[0].Height = 2
+				return req
+			},
+			sdkerrors.ErrInvalidRequest,
+		},
+		"empty tx": {
+			func(req *abci.RequestFinalizeBlock) *abci.RequestFinalizeBlock {
+				req.Txs = append(req.Txs, nil)
+				return req
+			},
+			sdkerrors.ErrInvalidRequest,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			err := suite.baseApp.ValidateFinalizeBlockRequest(tc.malleate(validReq()))
+			if tc.expErr == nil {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, tc.expErr)
+		})
+	}
+}
+
 func TestABCI_FinalizeBlock_WithBeginAndEndBlocker(t *testing.T) {
 	name := t.Name()
 	db := dbm.NewMemDB()
@@ -304,6 +873,55 @@ func TestABCI_FinalizeBlock_WithBeginAndEndBlocker(t *testing.T) {
 	require.Equal(t, int64(1), app.LastBlockHeight())
 }
 
+func TestABCI_FinalizeBlock_CoalesceBeginBlockEvents(t *testing.T) {
+	newEvent := func(typ, value string) abci.Event {
+		return abci.Event{
+			Type:       typ,
+			Attributes: []abci.EventAttribute{{Key: fooStr, Value: value}},
+		}
+	}
+
+	value := "bar"
+	beginBlockerOpt := func(bapp *baseapp.BaseApp) {
+		bapp.SetBeginBlocker(func(ctx sdk.Context) (sdk.BeginBlock, error) {
+			return sdk.BeginBlock{
+				Events: []abci.Event{newEvent("static", "bar"), newEvent("changing", value)},
+			}, nil
+		})
+	}
+
+	finalizeBlock := func(app *baseapp.BaseApp, height int64) []string {
+		res, err := app.FinalizeBlock(&abci.RequestFinalizeBlock{Height: height})
+		require.NoError(t, err)
+		_, err = app.Commit()
+		require.NoError(t, err)
+
+		var types []string
+		for _, event := range res.Events {
+			types = append(types, event.Type)
+		}
+		return types
+	}
+
+	// without coalescing every block emits both events
+	suite := NewBaseAppSuite(t, beginBlockerOpt)
+	_, err := suite.baseApp.InitChain(&abci.RequestInitChain{ConsensusParams: &cmtproto.ConsensusParams{}})
+	require.NoError(t, err)
+	require.Equal(t, []string{"static", "changing"}, finalizeBlock(suite.baseApp, 1))
+	require.Equal(t, []string{"static", "changing"}, finalizeBlock(suite.baseApp, 2))
+
+	// with coalescing only events that differ from the previous block are kept
+	suite = NewBaseAppSuite(t, beginBlockerOpt, baseapp.SetCoalesceBeginBlockEvents(true))
+	_, err = suite.baseApp.InitChain(&abci.RequestInitChain{ConsensusParams: &cmtproto.ConsensusParams{}})
+	require.NoError(t, err)
+	require.Equal(t, []string{"static", "changing"}, finalizeBlock(suite.baseApp, 1))
+	require.Equal(t, []string(nil), finalizeBlock(suite.baseApp, 2))
+
+	value = "baz"
+	require.Equal(t, []string{"changing"}, finalizeBlock(suite.baseApp, 3))
+	require.Equal(t, []string(nil), finalizeBlock(suite.baseApp, 4))
+}
+
 func TestABCI_ExtendVote(t *testing.T) {
 	name := t.Name()
 	db := dbm.NewMemDB()
@@ -489,6 +1107,162 @@ func TestABCI_GRPCQuery(t *testing.T) {
 	require.Equal(t, "Hello foo!", res.Greeting)
 }
 
+func TestABCI_Query_ErrorDetail(t *testing.T) {
+	grpcQueryOpt := func(bapp *baseapp.BaseApp) {
+		testdata.RegisterQueryServer(
+			bapp.GRPCQueryRouter(),
+			testdata.QueryImpl{},
+		)
+	}
+
+	suite := NewBaseAppSuite(t, grpcQueryOpt)
+
+	_, err := suite.baseApp.InitChain(&abci.RequestInitChain{
+		ConsensusParams: &cmtproto.ConsensusParams{},
+	})
+	require.NoError(t, err)
+
+	reqBz, err := (&testdata.SayHelloRequest{Name: fooStr}).Marshal()
+	require.NoError(t, err)
+
+	// the gRPC handler fails as no block has been committed yet
+	resQuery, err := suite.baseApp.Query(context.TODO(), &abci.RequestQuery{
+		Data: reqBz,
+		Path: "/testpb.Query/SayHello",
+	})
+	require.NoError(t, err)
+	require.Equal(t, sdkerrors.ErrInvalidHeight.ABCICode(), resQuery.Code)
+	require.Empty(t, resQuery.Value)
+
+	// the error is only described in the Log by default
+	require.Empty(t, resQuery.Info)
+
+	suite = NewBaseAppSuite(t, grpcQueryOpt, baseapp.SetQueryErrorInfo(true))
+
+	_, err = suite.baseApp.InitChain(&abci.RequestInitChain{
+		ConsensusParams: &cmtproto.ConsensusParams{},
+	})
+	require.NoError(t, err)
+
+	resQuery, err = suite.baseApp.Query(context.TODO(), &abci.RequestQuery{
+		Data: reqBz,
+		Path: "/testpb.Query/SayHello",
+	})
+	require.NoError(t, err)
+	require.Equal(t, sdkerrors.ErrInvalidHeight.ABCICode(), resQuery.Code)
+
+	var info baseapp.QueryInfo
+	require.NoError(t, json.Unmarshal([]byte(resQuery.Info), &info))
+	require.Equal(t, baseapp.QueryInfo{Error: &baseapp.QueryErrorInfo{
+		Code:      sdkerrors.ErrInvalidHeight.ABCICode(),
+		Codespace: sdkerrors.ErrInvalidHeight.Codespace(),
+		Message:   resQuery.Log,
+	}}, info)
+
+	// non gRPC query paths carry the same detail
+	resQuery, err = suite.baseApp.Query(context.TODO(), &abci.RequestQuery{Path: "/unknown"})
+	require.NoError(t, err)
+	info = baseapp.QueryInfo{}
+	require.NoError(t, json.Unmarshal([]byte(resQuery.Info), &info))
+	require.NotNil(t, info.Error)
+	require.Equal(t, sdkerrors.ErrUnknownRequest.ABCICode(), info.Error.Code)
+	require.Equal(t, sdkerrors.RootCodespace, info.Error.Codespace)
+	require.Equal(t, resQuery.Log, info.Error.Message)
+}
+
+func TestABCI_GRPCQuery_PruningWarning(t *testing.T) {
+	grpcQueryOpt := func(bapp *baseapp.BaseApp) {
+		testdata.RegisterQueryServer(
+			bapp.GRPCQueryRouter(),
+			testdata.QueryImpl{},
+		)
+	}
+
+	suite := NewBaseAppSuite(
+		t,
+		grpcQueryOpt,
+		baseapp.SetPruning(pruningtypes.NewCustomPruningOptions(10, 100)),
+		baseapp.SetQueryPruningWarningWindow(3),
+	)
+
+	_, err := suite.baseApp.InitChain(&abci.RequestInitChain{
+		ConsensusParams: &cmtproto.ConsensusParams{},
+	})
+	require.NoError(t, err)
+
+	for i := int64(1); i <= 15; i++ {
+		_, err = suite.baseApp.FinalizeBlock(&abci.RequestFinalizeBlock{Height: i})
+		require.NoError(t, err)
+		_, err = suite.baseApp.Commit()
+		require.NoError(t, err)
+	}
+
+	reqBz, err := (&testdata.SayHelloRequest{Name: fooStr}).Marshal()
+	require.NoError(t, err)
+
+	// the pruning cutoff is at height 5, so heights up to 8 are near pruning
+	resQuery, err := suite.baseApp.Query(context.TODO(), &abci.RequestQuery{
+		Data:   reqBz,
+		Path:   "/testpb.Query/SayHello",
+		Height: 7,
+	})
+	require.NoError(t, err)
+	require.Equal(t, abci.CodeTypeOK, resQuery.Code, resQuery)
+	require.Contains(t, resQuery.Log, "within 3 blocks of the pruning cutoff")
+
+	resQuery, err = suite.baseApp.Query(context.TODO(), &abci.RequestQuery{
+		Data:   reqBz,
+		Path:   "/testpb.Query/SayHello",
+		Height: 12,
+	})
+	require.NoError(t, err)
+	require.Equal(t, abci.CodeTypeOK, resQuery.Code, resQuery)
+	require.Empty(t, resQuery.Log)
+}
+
+func TestABCI_GRPCQuery_PruningWarning_CheckState(t *testing.T) {
+	grpcQueryOpt := func(bapp *baseapp.BaseApp) {
+		testdata.RegisterQueryServer(
+			bapp.GRPCQueryRouter(),
+			testdata.QueryImpl{},
+		)
+	}
+
+	suite := NewBaseAppSuite(
+		t,
+		grpcQueryOpt,
+		baseapp.SetPruning(pruningtypes.NewCustomPruningOptions(10, 100)),
+		baseapp.SetQueryPruningWarningWindow(3),
+		baseapp.SetCheckStateQueries(true),
+	)
+
+	_, err := suite.baseApp.InitChain(&abci.RequestInitChain{
+		ConsensusParams: &cmtproto.ConsensusParams{},
+	})
+	require.NoError(t, err)
+
+	for i := int64(1); i <= 15; i++ {
+		_, err = suite.baseApp.FinalizeBlock(&abci.RequestFinalizeBlock{Height: i})
+		require.NoError(t, err)
+		_, err = suite.baseApp.Commit()
+		require.NoError(t, err)
+	}
+
+	reqBz, err := (&testdata.SayHelloRequest{Name: fooStr}).Marshal()
+	require.NoError(t, err)
+
+	// check state queries are not made against committed state, so they are
+	// never near the pruning cutoff
+	resQuery, err := suite.baseApp.Query(context.TODO(), &abci.RequestQuery{
+		Data:   reqBz,
+		Path:   "/testpb.Query/SayHello",
+		Height: baseapp.CheckStateQueryHeight,
+	})
+	require.NoError(t, err)
+	require.Equal(t, abci.CodeTypeOK, resQuery.Code, resQuery)
+	require.Empty(t, resQuery.Log)
+}
+
 func TestABCI_P2PQuery(t *testing.T) {
 	addrPeerFilterOpt := func(bapp *baseapp.BaseApp) {
 		bapp.SetAddrPeerFilter(func(addrport string) *abci.ResponseQuery {
@@ -506,19 +1280,95 @@ func TestABCI_P2PQuery(t *testing.T) {
 
 	suite := NewBaseAppSuite(t, addrPeerFilterOpt, idPeerFilterOpt)
 
-	addrQuery := abci.RequestQuery{
-		Path: "/p2p/filter/addr/1.1.1.1:8000",
+	addrQuery := abci.RequestQuery{
+		Path: "/p2p/filter/addr/1.1.1.1:8000",
+	}
+	res, err := suite.baseApp.Query(context.TODO(), &addrQuery)
+	require.NoError(t, err)
+	require.Equal(t, uint32(3), res.Code)
+
+	idQuery := abci.RequestQuery{
+		Path: "/p2p/filter/id/testid",
+	}
+	res, err = suite.baseApp.Query(context.TODO(), &idQuery)
+	require.NoError(t, err)
+	require.Equal(t, uint32(4), res.Code)
+}
+
+func TestABCI_P2PQuery_IDPeerFilters(t *testing.T) {
+	var calls []string
+	filter := func(name string, code uint32) sdk.PeerFilter {
+		return func(id string) *abci.ResponseQuery {
+			calls = append(calls, name)
+			if id == "bad" {
+				return &abci.ResponseQuery{Code: code}
+			}
+			return &abci.ResponseQuery{}
+		}
+	}
+	nilFilter := func(id string) *abci.ResponseQuery {
+		calls = append(calls, "nil")
+		return nil
+	}
+
+	suite := NewBaseAppSuite(t, func(bapp *baseapp.BaseApp) {
+		bapp.SetIDPeerFilters(nilFilter, filter("allow", 0), filter("deny", 5), nil, filter("rate", 6))
+	})
+
+	// a peer accepted by every filter runs the whole chain
+	res, err := suite.baseApp.Query(context.TODO(), &abci.RequestQuery{Path: "/p2p/filter/id/good"})
+	require.NoError(t, err)
+	require.Equal(t, abci.CodeTypeOK, res.Code)
+	require.Equal(t, []string{"nil", "allow", "deny", "rate"}, calls)
+
+	// the first rejection wins and the remaining filters are skipped
+	calls = nil
+	res, err = suite.baseApp.Query(context.TODO(), &abci.RequestQuery{Path: "/p2p/filter/id/bad"})
+	require.NoError(t, err)
+	require.Equal(t, uint32(5), res.Code)
+	require.Equal(t, []string{"nil", "allow", "deny"}, calls)
+
+	// the single filter setter replaces the chain
+	calls = nil
+	suite = NewBaseAppSuite(t, func(bapp *baseapp.BaseApp) {
+		bapp.SetIDPeerFilters(filter("deny", 5))
+		bapp.SetIDPeerFilter(filter("rate", 6))
+	})
+	res, err = suite.baseApp.Query(context.TODO(), &abci.RequestQuery{Path: "/p2p/filter/id/bad"})
+	require.NoError(t, err)
+	require.Equal(t, uint32(6), res.Code)
+	require.Equal(t, []string{"rate"}, calls)
+
+	// without filters every peer is accepted
+	suite = NewBaseAppSuite(t)
+	res, err = suite.baseApp.Query(context.TODO(), &abci.RequestQuery{Path: "/p2p/filter/id/bad"})
+	require.NoError(t, err)
+	require.Equal(t, abci.CodeTypeOK, res.Code)
+}
+
+func TestABCI_Query_PanicRethrow(t *testing.T) {
+	panicFilterOpt := func(bapp *baseapp.BaseApp) {
+		bapp.SetIDPeerFilter(func(id string) *abci.ResponseQuery {
+			panic("peer filter panic")
+		})
 	}
-	res, err := suite.baseApp.Query(context.TODO(), &addrQuery)
-	require.NoError(t, err)
-	require.Equal(t, uint32(3), res.Code)
 
 	idQuery := abci.RequestQuery{
 		Path: "/p2p/filter/id/testid",
 	}
-	res, err = suite.baseApp.Query(context.TODO(), &idQuery)
+
+	// by default the panic is recovered and returned as a query result
+	suite := NewBaseAppSuite(t, panicFilterOpt)
+	res, err := suite.baseApp.Query(context.TODO(), &idQuery)
 	require.NoError(t, err)
-	require.Equal(t, uint32(4), res.Code)
+	require.Equal(t, sdkerrors.ErrPanic.ABCICode(), res.Code)
+	require.Contains(t, res.Log, "peer filter panic")
+
+	// with rethrow enabled the panic reaches the caller
+	suite = NewBaseAppSuite(t, panicFilterOpt, baseapp.SetQueryPanicRethrow(true))
+	require.PanicsWithValue(t, "peer filter panic", func() {
+		_, _ = suite.baseApp.Query(context.TODO(), &idQuery)
+	})
 }
 
 func TestBaseApp_PrepareCheckState(t *testing.T) {
@@ -579,6 +1429,285 @@ func TestBaseApp_Precommit(t *testing.T) {
 	require.Equal(t, true, wasPrecommiterCalled)
 }
 
+func TestABCI_FinalizeBlock_BlockerGasTelemetry(t *testing.T) {
+	sink := metrics.NewInmemSink(time.Hour, time.Hour)
+	cfg := metrics.DefaultConfig("test")
+	cfg.EnableHostname = false
+	cfg.EnableRuntimeMetrics = false
+	_, err := metrics.NewGlobal(cfg, sink)
+	require.NoError(t, err)
+	t.Cleanup(func() { _, _ = metrics.NewGlobal(metrics.DefaultConfig(""), &metrics.BlackholeSink{}) })
+
+	blockersOpt := func(bapp *baseapp.BaseApp) {
+		bapp.SetBeginBlocker(func(ctx sdk.Context) (sdk.BeginBlock, error) {
+			ctx.GasMeter().ConsumeGas(1234, "begin block hook")
+			return sdk.BeginBlock{}, nil
+		})
+		bapp.SetEndBlocker(func(ctx sdk.Context) (sdk.EndBlock, error) {
+			ctx.GasMeter().ConsumeGas(567, "end block hook")
+			return sdk.EndBlock{}, nil
+		})
+	}
+	suite := NewBaseAppSuite(t, blockersOpt)
+	baseapptestutil.RegisterCounterServer(suite.baseApp.MsgServiceRouter(), CounterServerImplGasMeterOnly{gas: 100})
+
+	_, err = suite.baseApp.InitChain(&abci.RequestInitChain{
+		ConsensusParams: &cmtproto.ConsensusParams{},
+	})
+	require.NoError(t, err)
+
+	txBytes, err := suite.txConfig.TxEncoder()(newTxCounter(t, suite.txConfig, 0, 0))
+	require.NoError(t, err)
+
+	// the gas used by txs is not attributed to the blockers
+	res, err := suite.baseApp.FinalizeBlock(&abci.RequestFinalizeBlock{Height: 1, Txs: [][]byte{txBytes}})
+	require.NoError(t, err)
+	require.Len(t, res.TxResults, 1)
+	require.True(t, res.TxResults[0].IsOK(), res.TxResults[0].Log)
+
+	data := sink.Data()
+	require.NotEmpty(t, data)
+	gauges := data[0].Gauges
+
+	require.Equal(t, float32(1234), gauges["test.begin_blocker.gas.used"].Value)
+	require.Equal(t, float32(567), gauges["test.end_blocker.gas.used"].Value)
+}
+
+func TestABCI_FinalizeBlock_MaxDuration(t *testing.T) {
+	var beginBlockDelay time.Duration
+	slowBeginBlocker := func(bapp *baseapp.BaseApp) {
+		bapp.SetBeginBlocker(func(ctx sdk.Context) (sdk.BeginBlock, error) {
+			time.Sleep(beginBlockDelay)
+			return sdk.BeginBlock{}, nil
+		})
+	}
+	suite := NewBaseAppSuite(t, slowBeginBlocker, baseapp.SetMaxFinalizeBlockDuration(50*time.Millisecond))
+
+	_, err := suite.baseApp.InitChain(&abci.RequestInitChain{
+		ConsensusParams: &cmtproto.ConsensusParams{},
+	})
+	require.NoError(t, err)
+
+	// a block within the max duration is finalized
+	_, err = suite.baseApp.FinalizeBlock(&abci.RequestFinalizeBlock{Height: 1})
+	require.NoError(t, err)
+	_, err = suite.baseApp.Commit()
+	require.NoError(t, err)
+
+	beginBlockDelay = 100 * time.Millisecond
+	_, err = suite.baseApp.FinalizeBlock(&abci.RequestFinalizeBlock{Height: 2})
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.ErrorContains(t, err, "finalize block at height 2 exceeded max duration 50ms")
+}
+
+func TestABCI_Query_CommitInfo(t *testing.T) {
+	suite := NewBaseAppSuite(t)
+
+	_, err := suite.baseApp.InitChain(&abci.RequestInitChain{
+		ConsensusParams: &cmtproto.ConsensusParams{},
+	})
+	require.NoError(t, err)
+
+	// no commit info before the first commit
+	_, err = suite.baseApp.LastCommitInfo()
+	require.Error(t, err)
+	res, err := suite.baseApp.Query(context.TODO(), &abci.RequestQuery{Path: "/app/commit-info"})
+	require.NoError(t, err)
+	require.Equal(t, sdkerrors.ErrInvalidRequest.ABCICode(), res.Code)
+
+	for height := int64(1); height <= 2; height++ {
+		_, err = suite.baseApp.FinalizeBlock(&abci.RequestFinalizeBlock{Height: height})
+		require.NoError(t, err)
+		_, err = suite.baseApp.Commit()
+		require.NoError(t, err)
+	}
+
+	cInfo, err := suite.baseApp.LastCommitInfo()
+	require.NoError(t, err)
+	require.Equal(t, int64(2), cInfo.Version)
+	require.Equal(t, suite.baseApp.LastCommitID(), cInfo.CommitID())
+
+	cms := suite.baseApp.CommitMultiStore()
+	require.Len(t, cInfo.StoreInfos, 2)
+	for _, key := range []*storetypes.KVStoreKey{capKey1, capKey2} {
+		var found bool
+		for _, storeInfo := range cInfo.StoreInfos {
+			if storeInfo.Name == key.Name() {
+				require.Equal(t, cms.GetCommitKVStore(key).LastCommitID(), storeInfo.CommitId)
+				found = true
+			}
+		}
+		require.True(t, found, "missing commit info of store %s", key.Name())
+	}
+
+	res, err = suite.baseApp.Query(context.TODO(), &abci.RequestQuery{Path: "/app/commit-info"})
+	require.NoError(t, err)
+	require.True(t, res.IsOK(), res.Log)
+
+	var queried storetypes.CommitInfo
+	require.NoError(t, json.Unmarshal(res.Value, &queried))
+	require.Equal(t, cInfo.Version, queried.Version)
+	require.Equal(t, cInfo.StoreInfos, queried.StoreInfos)
+}
+
+func TestABCI_Query_BlockGas(t *testing.T) {
+	anteOpt := func(bapp *baseapp.BaseApp) {
+		bapp.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+			return ctx.WithGasMeter(storetypes.NewGasMeter(100000)), nil
+		})
+	}
+	suite := NewBaseAppSuite(t, anteOpt)
+
+	var infos []baseapp.BlockGasInfo
+	baseapptestutil.RegisterCounterServer(suite.baseApp.MsgServiceRouter(), BlockGasInfoServerImpl{suite.baseApp, 1000, &infos})
+
+	_, err := suite.baseApp.InitChain(&abci.RequestInitChain{
+		ConsensusParams: &cmtproto.ConsensusParams{Block: &cmtproto.BlockParams{MaxGas: 50000}},
+	})
+	require.NoError(t, err)
+
+	// no block gas info before the first block
+	require.Nil(t, suite.baseApp.CurrentBlockGasInfo())
+	res, err := suite.baseApp.Query(context.TODO(), &abci.RequestQuery{Path: "/app/block-gas"})
+	require.NoError(t, err)
+	require.Equal(t, sdkerrors.ErrInvalidRequest.ABCICode(), res.Code)
+
+	var txs [][]byte
+	for i := int64(0); i < 3; i++ {
+		txBytes, err := suite.txConfig.TxEncoder()(newTxCounter(t, suite.txConfig, i, i))
+		require.NoError(t, err)
+		txs = append(txs, txBytes)
+	}
+
+	resFinalize, err := suite.baseApp.FinalizeBlock(&abci.RequestFinalizeBlock{Height: 1, Txs: txs})
+	require.NoError(t, err)
+
+	// while the block is finalized, the info accounts for the previous txs
+	require.Len(t, infos, len(txs))
+	var consumed uint64
+	for i, txRes := range resFinalize.TxResults {
+		require.Equal(t, baseapp.BlockGasInfo{Height: 1, Consumed: consumed, Limit: 50000, InProgress: true}, infos[i])
+		require.GreaterOrEqual(t, txRes.GasUsed, int64(1000))
+		consumed += uint64(txRes.GasUsed)
+	}
+
+	// once the block is finalized, the info holds its totals
+	expInfo := baseapp.BlockGasInfo{Height: 1, Consumed: consumed, Limit: 50000}
+	require.Equal(t, &expInfo, suite.baseApp.CurrentBlockGasInfo())
+
+	res, err = suite.baseApp.Query(context.TODO(), &abci.RequestQuery{Path: "/app/block-gas"})
+	require.NoError(t, err)
+	require.True(t, res.IsOK(), res.Log)
+
+	var info baseapp.BlockGasInfo
+	require.NoError(t, json.Unmarshal(res.Value, &info))
+	require.Equal(t, expInfo, info)
+}
+
+func TestABCI_FinalizeBlock_BlockGasTelemetry(t *testing.T) {
+	sink := metrics.NewInmemSink(time.Hour, time.Hour)
+	cfg := metrics.DefaultConfig("test")
+	cfg.EnableHostname = false
+	cfg.EnableRuntimeMetrics = false
+	_, err := metrics.NewGlobal(cfg, sink)
+	require.NoError(t, err)
+	t.Cleanup(func() { _, _ = metrics.NewGlobal(metrics.DefaultConfig(""), &metrics.BlackholeSink{}) })
+
+	blockersOpt := func(bapp *baseapp.BaseApp) {
+		bapp.SetBeginBlocker(func(ctx sdk.Context) (sdk.BeginBlock, error) {
+			ctx.GasMeter().ConsumeGas(1234, "begin block hook")
+			return sdk.BeginBlock{}, nil
+		})
+		bapp.SetEndBlocker(func(ctx sdk.Context) (sdk.EndBlock, error) {
+			ctx.GasMeter().ConsumeGas(567, "end block hook")
+			return sdk.EndBlock{}, nil
+		})
+	}
+	suite := NewBaseAppSuite(t, blockersOpt)
+	baseapptestutil.RegisterCounterServer(suite.baseApp.MsgServiceRouter(), CounterServerImplGasMeterOnly{gas: 100})
+
+	_, err = suite.baseApp.InitChain(&abci.RequestInitChain{
+		ConsensusParams: &cmtproto.ConsensusParams{},
+	})
+	require.NoError(t, err)
+
+	var txs [][]byte
+	for i := int64(0); i < 3; i++ {
+		txBytes, err := suite.txConfig.TxEncoder()(newTxCounter(t, suite.txConfig, i, i))
+		require.NoError(t, err)
+		txs = append(txs, txBytes)
+	}
+	txs = append(txs, []byte("undecodable"))
+
+	res, err := suite.baseApp.FinalizeBlock(&abci.RequestFinalizeBlock{Height: 1, Txs: txs})
+	require.NoError(t, err)
+	require.Len(t, res.TxResults, len(txs))
+
+	var txsGasUsed int64
+	for _, txRes := range res.TxResults {
+		txsGasUsed += txRes.GasUsed
+	}
+	require.Positive(t, txsGasUsed)
+
+	data := sink.Data()
+	require.NotEmpty(t, data)
+	gauges := data[0].Gauges
+
+	require.Equal(t, float32(txsGasUsed+1234+567), gauges["test.block.gas.used"].Value)
+	require.Equal(t, float32(len(txs)), gauges["test.block.tx.count"].Value)
+}
+
+func TestABCI_CheckTx_Telemetry(t *testing.T) {
+	sink := metrics.NewInmemSink(time.Hour, time.Hour)
+	cfg := metrics.DefaultConfig("test")
+	cfg.EnableHostname = false
+	cfg.EnableRuntimeMetrics = false
+	_, err := metrics.NewGlobal(cfg, sink)
+	require.NoError(t, err)
+	t.Cleanup(func() { _, _ = metrics.NewGlobal(metrics.DefaultConfig(""), &metrics.BlackholeSink{}) })
+
+	counterKey := []byte("counter-key")
+	anteOpt := func(bapp *baseapp.BaseApp) { bapp.SetAnteHandler(anteHandlerTxTest(t, capKey1, counterKey)) }
+	suite := NewBaseAppSuite(t, anteOpt)
+	baseapptestutil.RegisterCounterServer(suite.baseApp.MsgServiceRouter(), CounterServerImpl{t, capKey1, counterKey})
+
+	_, err = suite.baseApp.InitChain(&abci.RequestInitChain{
+		ConsensusParams: &cmtproto.ConsensusParams{},
+	})
+	require.NoError(t, err)
+
+	encode := func(counter int64, failOnAnte bool) []byte {
+		tx := setFailOnAnte(t, suite.txConfig, newTxCounter(t, suite.txConfig, counter, 0), failOnAnte)
+		txBytes, err := suite.txConfig.TxEncoder()(tx)
+		require.NoError(t, err)
+		return txBytes
+	}
+
+	// both successful and failing transactions are counted, for each type
+	for _, req := range []*abci.RequestCheckTx{
+		{Tx: encode(0, false), Type: abci.CheckTxType_New},
+		{Tx: encode(1, true), Type: abci.CheckTxType_New},
+		{Tx: encode(1, false), Type: abci.CheckTxType_Recheck},
+		{Tx: encode(2, true), Type: abci.CheckTxType_Recheck},
+		{Tx: encode(2, true), Type: abci.CheckTxType_Recheck},
+	} {
+		_, err := suite.baseApp.CheckTx(req)
+		require.NoError(t, err)
+	}
+
+	data := sink.Data()
+	require.NotEmpty(t, data)
+	counters := data[0].Counters
+	gauges := data[0].Gauges
+
+	require.Equal(t, 2, counters["test.checktx;type=new"].Count)
+	require.Equal(t, 3, counters["test.checktx;type=recheck"].Count)
+	require.Contains(t, gauges, "test.checktx.gas.used;type=new")
+	require.Contains(t, gauges, "test.checktx.gas.used;type=recheck")
+	require.Contains(t, gauges, "test.checktx.gas.wanted;type=new")
+	require.Contains(t, gauges, "test.checktx.gas.wanted;type=recheck")
+}
+
 func TestABCI_CheckTx(t *testing.T) {
 	// This ante handler reads the key and checks that the value matches the
 	// current counter. This ensures changes to the KVStore persist across
@@ -681,6 +1810,93 @@ func TestABCI_FinalizeBlock_DeliverTx(t *testing.T) {
 	}
 }
 
+func TestABCI_FinalizeBlock_DeliverTxEventTransformer(t *testing.T) {
+	anteKey := []byte("ante-key")
+	suite := NewBaseAppSuite(t, func(bapp *baseapp.BaseApp) {
+		bapp.SetAnteHandler(anteHandlerTxTest(t, capKey1, anteKey))
+		bapp.SetDeliverTxEventTransformer(func(events []abci.Event) []abci.Event {
+			return slices.DeleteFunc(events, func(e abci.Event) bool { return e.Type == "ante_handler" })
+		})
+	})
+	baseapptestutil.RegisterCounterServer(suite.baseApp.MsgServiceRouter(), CounterServerImpl{t, capKey1, []byte("deliver-key")})
+
+	_, err := suite.baseApp.InitChain(&abci.RequestInitChain{
+		ConsensusParams: &cmtproto.ConsensusParams{},
+	})
+	require.NoError(t, err)
+
+	okTx, err := suite.txConfig.TxEncoder()(newTxCounter(t, suite.txConfig, 0, 0))
+	require.NoError(t, err)
+	failedTx, err := suite.txConfig.TxEncoder()(setFailOnHandler(t, suite.txConfig, newTxCounter(t, suite.txConfig, 1, 1), true))
+	require.NoError(t, err)
+
+	res, err := suite.baseApp.FinalizeBlock(&abci.RequestFinalizeBlock{Height: 1, Txs: [][]byte{okTx, failedTx}})
+	require.NoError(t, err)
+	require.True(t, res.TxResults[0].IsOK(), res.TxResults[0].Log)
+	require.False(t, res.TxResults[1].IsOK())
+
+	// the message events are kept while the ante handler events are dropped
+	require.Len(t, res.TxResults[0].Events, 2)
+	for _, txResult := range res.TxResults {
+		for _, event := range txResult.Events {
+			require.NotEqual(t, "ante_handler", event.Type)
+			for _, attr := range event.Attributes {
+				require.True(t, attr.Index, "remaining events are still marked for indexing")
+			}
+		}
+	}
+}
+
+func TestABCI_FinalizeBlock_TxResultLogs(t *testing.T) {
+	anteKey := []byte("ante-key")
+	anteOpt := func(bapp *baseapp.BaseApp) {
+		bapp.SetAnteHandler(anteHandlerTxTest(t, capKey1, anteKey))
+	}
+	suite := NewBaseAppSuite(t, anteOpt)
+	baseapptestutil.RegisterCounterServer(suite.baseApp.MsgServiceRouter(), CounterServerImpl{t, capKey1, []byte("deliver-key")})
+
+	_, err := suite.baseApp.InitChain(&abci.RequestInitChain{
+		ConsensusParams: &cmtproto.ConsensusParams{},
+	})
+	require.NoError(t, err)
+
+	encode := func(tx signing.Tx) []byte {
+		bz, err := suite.txConfig.TxEncoder()(tx)
+		require.NoError(t, err)
+		return bz
+	}
+	txs := [][]byte{
+		encode(setFailOnAnte(t, suite.txConfig, newTxCounter(t, suite.txConfig, 0, 0), true)),
+		encode(setFailOnHandler(t, suite.txConfig, newTxCounter(t, suite.txConfig, 0, 0), true)),
+		encode(newTxCounter(t, suite.txConfig, 1, 0)),
+		[]byte("invalid tx"),
+	}
+
+	res, err := suite.baseApp.FinalizeBlock(&abci.RequestFinalizeBlock{Height: 1, Txs: txs})
+	require.NoError(t, err)
+	require.Len(t, res.TxResults, len(txs))
+
+	var lines []string
+	for _, line := range strings.Split(suite.logBuffer.String(), "\n") {
+		if strings.Contains(line, "executed tx") {
+			lines = append(lines, line)
+		}
+	}
+	require.Len(t, lines, len(txs))
+
+	for i, line := range lines {
+		result := res.TxResults[i]
+		require.Contains(t, line, "DBG")
+		require.Contains(t, line, fmt.Sprintf("tx_index=%d", i))
+		require.Contains(t, line, fmt.Sprintf("code=%d", result.Code))
+		require.Contains(t, line, fmt.Sprintf("gas_used=%d", result.GasUsed))
+	}
+	require.True(t, res.TxResults[2].IsOK())
+	require.False(t, res.TxResults[0].IsOK())
+	require.False(t, res.TxResults[1].IsOK())
+	require.Contains(t, lines[3], fmt.Sprintf("code=%d", sdkerrors.ErrTxDecode.ABCICode()))
+}
+
 func TestABCI_FinalizeBlock_MultiMsg(t *testing.T) {
 	anteKey := []byte("ante-key")
 	anteOpt := func(bapp *baseapp.BaseApp) { bapp.SetAnteHandler(anteHandlerTxTest(t, capKey1, anteKey)) }
@@ -809,15 +2025,222 @@ func TestABCI_Query_SimulateTx(t *testing.T) {
 		require.NoError(t, jsonpb.Unmarshal(strings.NewReader(string(queryResult.Value)), &simRes))
 
 		require.Equal(t, gInfo, simRes.GasInfo)
+		var info baseapp.QueryInfo
+		require.NoError(t, json.Unmarshal([]byte(queryResult.Info), &info))
+		require.Equal(t, baseapp.QueryInfo{GasInfo: &simRes.GasInfo}, info)
 		require.Equal(t, result.Log, simRes.Result.Log)
 		require.Equal(t, result.Events, simRes.Result.Events)
 		require.True(t, bytes.Equal(result.Data, simRes.Result.Data))
 
-		_, err = suite.baseApp.FinalizeBlock(&abci.RequestFinalizeBlock{Height: count})
+		_, err = suite.baseApp.FinalizeBlock(&abci.RequestFinalizeBlock{Height: count})
+		require.NoError(t, err)
+		_, err = suite.baseApp.Commit()
+		require.NoError(t, err)
+	}
+}
+
+func TestABCI_Query_SimulateTx_NoMessages(t *testing.T) {
+	suite := NewBaseAppSuite(t)
+
+	_, err := suite.baseApp.InitChain(&abci.RequestInitChain{
+		ConsensusParams: &cmtproto.ConsensusParams{},
+	})
+	require.NoError(t, err)
+
+	tx := newTxCounter(t, suite.txConfig, 0)
+	require.Empty(t, tx.GetMsgs())
+
+	txBytes, err := suite.txConfig.TxEncoder()(tx)
+	require.NoError(t, err)
+
+	queryResult, err := suite.baseApp.Query(context.TODO(), &abci.RequestQuery{
+		Path: "/app/simulate",
+		Data: txBytes,
+	})
+	require.NoError(t, err)
+	require.False(t, queryResult.IsOK())
+	require.Equal(t, sdkerrors.ErrInvalidRequest.ABCICode(), queryResult.Code)
+	require.Contains(t, queryResult.Log, "tx contains no messages")
+}
+
+func TestABCI_Query_SimulateTx_Encoding(t *testing.T) {
+	gasConsumed := uint64(5)
+	anteOpt := func(bapp *baseapp.BaseApp) {
+		bapp.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (newCtx sdk.Context, err error) {
+			newCtx = ctx.WithGasMeter(storetypes.NewGasMeter(gasConsumed))
+			return
+		})
+	}
+
+	suite := NewBaseAppSuite(t, anteOpt)
+	baseapptestutil.RegisterCounterServer(suite.baseApp.MsgServiceRouter(), CounterServerImplGasMeterOnly{gasConsumed})
+
+	_, err := suite.baseApp.InitChain(&abci.RequestInitChain{
+		ConsensusParams: &cmtproto.ConsensusParams{},
+	})
+	require.NoError(t, err)
+
+	txBytes, err := suite.txConfig.TxEncoder()(newTxCounter(t, suite.txConfig, 1, 1))
+	require.NoError(t, err)
+
+	query := func(path string) *abci.ResponseQuery {
+		res, err := suite.baseApp.Query(context.TODO(), &abci.RequestQuery{Path: path, Data: txBytes})
+		require.NoError(t, err)
+		return res
+	}
+
+	jsonRes := query("/app/simulate")
+	require.True(t, jsonRes.IsOK(), jsonRes.Log)
+	require.Equal(t, "encoding=json", jsonRes.Log)
+	require.Equal(t, jsonRes.Value, query("/app/simulate/json").Value)
+
+	var fromJSON sdk.SimulationResponse
+	require.NoError(t, jsonpb.Unmarshal(strings.NewReader(string(jsonRes.Value)), &fromJSON))
+
+	protoRes := query("/app/simulate/proto")
+	require.True(t, protoRes.IsOK(), protoRes.Log)
+	require.Equal(t, "encoding=proto", protoRes.Log)
+	require.Equal(t, jsonRes.Info, protoRes.Info)
+
+	var fromProto sdk.SimulationResponse
+	require.NoError(t, fromProto.Unmarshal(protoRes.Value))
+
+	require.Equal(t, gasConsumed, fromProto.GasInfo.GasUsed)
+	require.Equal(t, fromJSON.GasInfo, fromProto.GasInfo)
+
+	// both encodings decode to the same response, up to nil and empty bytes
+	fromJSONBz, err := fromJSON.Marshal()
+	require.NoError(t, err)
+	require.Equal(t, protoRes.Value, fromJSONBz)
+
+	unknownRes := query("/app/simulate/xml")
+	require.False(t, unknownRes.IsOK())
+	require.Equal(t, sdkerrors.ErrInvalidRequest.ABCICode(), unknownRes.Code)
+	require.Contains(t, unknownRes.Log, "unknown simulate response encoding xml")
+}
+
+func TestABCI_Query_SimulateTx_Deterministic(t *testing.T) {
+	gasConsumed := uint64(5)
+	var nonDeterministicGas uint64
+	anteOpt := func(bapp *baseapp.BaseApp) {
+		// the regular ante handler charges a different amount of gas on every run
+		bapp.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+			nonDeterministicGas++
+			ctx = ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+			ctx.GasMeter().ConsumeGas(nonDeterministicGas, "non-deterministic")
+			return ctx, nil
+		})
+		bapp.SetDeterministicSimulateAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+			require.True(t, simulate)
+			require.Equal(t, baseapp.DeterministicSimulateBlockTime, ctx.HeaderInfo().Time)
+			require.Equal(t, baseapp.DeterministicSimulateBlockTime, ctx.BlockHeader().Time)
+			return ctx.WithGasMeter(storetypes.NewInfiniteGasMeter()), nil
+		})
+	}
+
+	suite := NewBaseAppSuite(t, anteOpt)
+	baseapptestutil.RegisterCounterServer(suite.baseApp.MsgServiceRouter(), CounterServerImplGasMeterOnly{gasConsumed})
+
+	_, err := suite.baseApp.InitChain(&abci.RequestInitChain{
+		ConsensusParams: &cmtproto.ConsensusParams{},
+		Time:            time.Now(),
+	})
+	require.NoError(t, err)
+
+	txBytes, err := suite.txConfig.TxEncoder()(newTxCounter(t, suite.txConfig, 1, 1))
+	require.NoError(t, err)
+
+	query := func(path string) *abci.ResponseQuery {
+		res, err := suite.baseApp.Query(context.TODO(), &abci.RequestQuery{Path: path, Data: txBytes})
+		require.NoError(t, err)
+		require.True(t, res.IsOK(), res.Log)
+		return res
+	}
+
+	// without the flag the gas estimate changes between runs
+	require.NotEqual(t, query("/app/simulate").Info, query("/app/simulate").Info)
+
+	// with the flag two runs yield identical gas
+	first, second := query("/app/simulate/deterministic"), query("/app/simulate/deterministic")
+	require.Equal(t, first.Info, second.Info)
+	require.Equal(t, first.Value, second.Value)
+	require.Equal(t, "encoding=json", first.Log)
+
+	// the flag combines with the response encoding
+	protoRes := query("/app/simulate/proto/deterministic")
+	require.Equal(t, "encoding=proto", protoRes.Log)
+	require.Equal(t, first.Info, protoRes.Info)
+
+	var simRes sdk.SimulationResponse
+	require.NoError(t, simRes.Unmarshal(protoRes.Value))
+	require.Equal(t, gasConsumed, simRes.GasInfo.GasUsed)
+}
+
+func TestABCI_Query_SimulateBatch(t *testing.T) {
+	balanceKey := []byte("balance")
+	suite := NewBaseAppSuite(t)
+	baseapptestutil.RegisterCounterServer(suite.baseApp.MsgServiceRouter(), FundServerImpl{t, capKey1, balanceKey})
+	baseapptestutil.RegisterCounter2Server(suite.baseApp.MsgServiceRouter(), SpendServerImpl{t, capKey1, balanceKey})
+
+	_, err := suite.baseApp.InitChain(&abci.RequestInitChain{
+		ConsensusParams: &cmtproto.ConsensusParams{},
+	})
+	require.NoError(t, err)
+
+	_, _, addr := testdata.KeyTestPubAddr()
+	newTx := func(msg sdk.Msg) []byte {
+		builder := suite.txConfig.NewTxBuilder()
+		require.NoError(t, builder.SetMsgs(msg))
+		setTxSignature(t, builder, 0)
+		txBytes, err := suite.txConfig.TxEncoder()(builder.GetTx())
 		require.NoError(t, err)
-		_, err = suite.baseApp.Commit()
+		return txBytes
+	}
+	fund := func(amount int64) []byte {
+		return newTx(&baseapptestutil.MsgCounter{Counter: amount, Signer: addr.String()})
+	}
+	spend := func(amount int64) []byte {
+		return newTx(&baseapptestutil.MsgCounter2{Counter: amount, Signer: addr.String()})
+	}
+	simulateBatch := func(txs ...[]byte) *abci.ResponseQuery {
+		data, err := json.Marshal(baseapp.SimulateBatchRequest{Txs: txs})
+		require.NoError(t, err)
+		res, err := suite.baseApp.Query(context.TODO(), &abci.RequestQuery{Path: "/app/simulate-batch", Data: data})
 		require.NoError(t, err)
+		return res
 	}
+
+	// the spend sees the balance funded by the previous tx
+	res := simulateBatch(fund(10), spend(7))
+	require.True(t, res.IsOK(), res.Log)
+
+	var batchRes baseapp.SimulateBatchResponse
+	require.NoError(t, json.Unmarshal(res.Value, &batchRes))
+	require.Len(t, batchRes.GasInfos, 2)
+	require.Equal(t, batchRes.GasInfos[0].GasUsed+batchRes.GasInfos[1].GasUsed, batchRes.GasUsed)
+	require.Equal(t, batchRes.GasInfos[0].GasWanted+batchRes.GasInfos[1].GasWanted, batchRes.GasWanted)
+	require.Positive(t, batchRes.GasInfos[1].GasUsed)
+
+	// the batch state is not committed, so a later batch does not see it
+	res = simulateBatch(spend(7))
+	require.False(t, res.IsOK())
+	require.Contains(t, res.Log, "failed to simulate tx 0")
+	require.Contains(t, res.Log, "insufficient balance 0 to spend 7")
+	require.Zero(t, getIntFromStore(t, getCheckStateCtx(suite.baseApp).KVStore(capKey1), balanceKey))
+
+	// the batch fails on the first tx overspending the accumulated balance
+	res = simulateBatch(fund(5), spend(3), spend(3))
+	require.False(t, res.IsOK())
+	require.Contains(t, res.Log, "failed to simulate tx 2")
+	require.Contains(t, res.Log, "insufficient balance 2 to spend 3")
+
+	res = simulateBatch()
+	require.Equal(t, sdkerrors.ErrInvalidRequest.ABCICode(), res.Code)
+	require.Contains(t, res.Log, "no txs to simulate")
+
+	res, err = suite.baseApp.Query(context.TODO(), &abci.RequestQuery{Path: "/app/simulate-batch", Data: []byte("invalid")})
+	require.NoError(t, err)
+	require.Equal(t, sdkerrors.ErrInvalidRequest.ABCICode(), res.Code)
 }
 
 func TestABCI_InvalidTransaction(t *testing.T) {
@@ -1182,6 +2605,51 @@ func TestABCI_GasConsumptionBadTx(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestABCI_Query_QueryableStores(t *testing.T) {
+	key, value := []byte("hello"), []byte("goodbye")
+
+	testCases := []struct {
+		name            string
+		queryableStores []string
+		expAllowed      map[string]bool
+	}{
+		{"empty allowlist", nil, map[string]bool{capKey1.Name(): true, capKey2.Name(): true}},
+		{"allowlisted store", []string{capKey1.Name()}, map[string]bool{capKey1.Name(): true, capKey2.Name(): false}},
+		{"no allowlisted mounted store", []string{"other"}, map[string]bool{capKey1.Name(): false, capKey2.Name(): false}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			suite := NewBaseAppSuite(t, baseapp.SetQueryableStores(tc.queryableStores...))
+			_, err := suite.baseApp.InitChain(&abci.RequestInitChain{
+				ConsensusParams: &cmtproto.ConsensusParams{},
+			})
+			require.NoError(t, err)
+
+			for _, storeKey := range []storetypes.StoreKey{capKey1, capKey2} {
+				suite.baseApp.CommitMultiStore().GetKVStore(storeKey).Set(key, value)
+			}
+			suite.baseApp.CommitMultiStore().Commit()
+
+			for storeName, allowed := range tc.expAllowed {
+				res, err := suite.baseApp.Query(context.TODO(), &abci.RequestQuery{
+					Path: fmt.Sprintf("/store/%s/key", storeName),
+					Data: key,
+				})
+				require.NoError(t, err)
+
+				if allowed {
+					require.True(t, res.IsOK(), res.Log)
+					require.Equal(t, value, res.Value)
+				} else {
+					require.Equal(t, sdkerrors.ErrUnauthorized.ABCICode(), res.Code)
+					require.Contains(t, res.Log, fmt.Sprintf("store %s is not queryable", storeName))
+				}
+			}
+		})
+	}
+}
+
 func TestABCI_Query(t *testing.T) {
 	key, value := []byte("hello"), []byte("goodbye")
 	anteOpt := func(bapp *baseapp.BaseApp) {
@@ -1245,6 +2713,94 @@ func TestABCI_Query(t *testing.T) {
 	require.Equal(t, value, res.Value)
 }
 
+func TestABCI_Query_SubspaceTruncation(t *testing.T) {
+	value := bytes.Repeat([]byte{0x01}, 32)
+	anteOpt := func(bapp *baseapp.BaseApp) {
+		bapp.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (newCtx sdk.Context, err error) {
+			store := ctx.KVStore(capKey1)
+			for i := 0; i < 50; i++ {
+				store.Set([]byte(fmt.Sprintf("p/%03d", i)), value)
+			}
+			return
+		})
+	}
+
+	suite := NewBaseAppSuite(t, anteOpt, baseapp.SetQueryMaxResponseBytes(1000))
+	baseapptestutil.RegisterCounterServer(suite.baseApp.MsgServiceRouter(), CounterServerImplGasMeterOnly{})
+
+	_, err := suite.baseApp.InitChain(&abci.RequestInitChain{
+		ConsensusParams: &cmtproto.ConsensusParams{},
+	})
+	require.NoError(t, err)
+
+	bz, err := suite.txConfig.TxEncoder()(newTxCounter(t, suite.txConfig, 0, 0))
+	require.NoError(t, err)
+	_, err = suite.baseApp.FinalizeBlock(&abci.RequestFinalizeBlock{Height: 1, Txs: [][]byte{bz}})
+	require.NoError(t, err)
+	_, err = suite.baseApp.Commit()
+	require.NoError(t, err)
+
+	countPairs := func(bz []byte) int {
+		var count int
+		for len(bz) > 0 {
+			_, _, n := protowire.ConsumeField(bz)
+			require.Positive(t, n)
+			bz = bz[n:]
+			count++
+		}
+		return count
+	}
+
+	// the 10 pairs under "p/00" fit in the limit
+	res, err := suite.baseApp.Query(context.TODO(), &abci.RequestQuery{Path: "/store/key1/subspace", Data: []byte("p/00")})
+	require.NoError(t, err)
+	require.Empty(t, res.Info)
+	require.Equal(t, 10, countPairs(res.Value))
+
+	// all the 50 pairs do not, the result is cut at the last pair that fits:
+	// each encoded pair takes 43 bytes, so 23 of them fit in 1000 bytes
+	res, err = suite.baseApp.Query(context.TODO(), &abci.RequestQuery{Path: "/store/key1/subspace", Data: []byte("p/")})
+	require.NoError(t, err)
+	require.JSONEq(t, `{"truncated":true}`, res.Info)
+	require.Len(t, res.Value, 23*43)
+	require.Equal(t, 23, countPairs(res.Value))
+}
+
+func TestABCI_Query_StoreTelemetry(t *testing.T) {
+	sink := metrics.NewInmemSink(time.Hour, time.Hour)
+	cfg := metrics.DefaultConfig("test")
+	cfg.EnableHostname = false
+	cfg.EnableRuntimeMetrics = false
+	_, err := metrics.NewGlobal(cfg, sink)
+	require.NoError(t, err)
+	t.Cleanup(func() { _, _ = metrics.NewGlobal(metrics.DefaultConfig(""), &metrics.BlackholeSink{}) })
+
+	suite := NewBaseAppSuite(t)
+
+	_, err = suite.baseApp.InitChain(&abci.RequestInitChain{
+		ConsensusParams: &cmtproto.ConsensusParams{},
+	})
+	require.NoError(t, err)
+
+	_, err = suite.baseApp.FinalizeBlock(&abci.RequestFinalizeBlock{Height: 1})
+	require.NoError(t, err)
+	_, err = suite.baseApp.Commit()
+	require.NoError(t, err)
+
+	_, err = suite.baseApp.Query(context.TODO(), &abci.RequestQuery{
+		Path: "/store/key1/key",
+		Data: []byte("foo"),
+	})
+	require.NoError(t, err)
+
+	data := sink.Data()
+	require.NotEmpty(t, data)
+
+	sample, ok := data[0].Samples["test.query.store;store=key1"]
+	require.True(t, ok, "store query latency should be recorded")
+	require.Equal(t, 1, sample.Count)
+}
+
 func TestABCI_GetBlockRetentionHeight(t *testing.T) {
 	logger := log.NewTestLogger(t)
 	db := dbm.NewMemDB()
@@ -1355,6 +2911,98 @@ func TestABCI_GetBlockRetentionHeight(t *testing.T) {
 	}
 }
 
+func TestABCI_GetBlockRetentionHeight_NoEvidenceParams(t *testing.T) {
+	testCases := map[string]struct {
+		retainAll bool
+		expected  int64
+		expLog    string
+	}{
+		"min retain blocks only": {
+			retainAll: false,
+			expected:  99000,
+			expLog:    "no evidence consensus params; block retention ignores the evidence max age",
+		},
+		"retain all blocks": {
+			retainAll: true,
+			expected:  0,
+			expLog:    "no evidence consensus params; retaining all blocks",
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+
+		t.Run(name, func(t *testing.T) {
+			logBuffer := new(bytes.Buffer)
+			bapp := baseapp.NewBaseApp(
+				t.Name(), log.NewLogger(logBuffer, log.ColorOption(false)), dbm.NewMemDB(), nil,
+				baseapp.SetMinRetainBlocks(400000),
+				baseapp.SetRetainAllBlocksWithoutEvidenceParams(tc.retainAll),
+			)
+			bapp.SetParamStore(&paramStore{db: dbm.NewMemDB()})
+
+			_, err := bapp.InitChain(&abci.RequestInitChain{
+				ConsensusParams: &cmtproto.ConsensusParams{},
+			})
+			require.NoError(t, err)
+
+			require.Equal(t, tc.expected, bapp.GetBlockRetentionHeight(499000))
+			require.Equal(t, tc.expected, bapp.GetBlockRetentionHeight(499000))
+			require.Equal(t, 1, strings.Count(logBuffer.String(), tc.expLog))
+
+			// the warning is logged again once evidence params are set and removed
+			ctx := bapp.NewContext(false)
+			require.NoError(t, bapp.StoreConsensusParams(ctx, cmtproto.ConsensusParams{
+				Evidence: &cmtproto.EvidenceParams{MaxAgeNumBlocks: 1},
+			}))
+			bapp.GetBlockRetentionHeight(499002)
+			require.NoError(t, bapp.StoreConsensusParams(ctx, cmtproto.ConsensusParams{}))
+			bapp.GetBlockRetentionHeight(499003)
+			require.Equal(t, 2, strings.Count(logBuffer.String(), tc.expLog))
+		})
+	}
+}
+
+func TestABCI_RetentionHeightQuery(t *testing.T) {
+	snapshotStore, err := snapshots.NewStore(dbm.NewMemDB(), testutil.GetTempDir(t))
+	require.NoError(t, err)
+
+	app := baseapp.NewBaseApp(
+		t.Name(), log.NewTestLogger(t), dbm.NewMemDB(), nil,
+		baseapp.SetPruning(pruningtypes.NewCustomPruningOptions(0, 0)),
+		baseapp.SetMinRetainBlocks(400000),
+		baseapp.SetSnapshot(snapshotStore, snapshottypes.NewSnapshotOptions(50000, 3)),
+	)
+	app.SetParamStore(&paramStore{db: dbm.NewMemDB()})
+
+	_, err = app.InitChain(&abci.RequestInitChain{
+		InitialHeight: 499000,
+		ConsensusParams: &cmtproto.ConsensusParams{
+			Evidence: &cmtproto.EvidenceParams{
+				MaxAgeNumBlocks: 362880,
+			},
+		},
+	})
+	require.NoError(t, err)
+	_, err = app.Commit()
+	require.NoError(t, err)
+	require.Equal(t, int64(499000), app.LastBlockHeight())
+
+	res, err := app.Query(context.TODO(), &abci.RequestQuery{Path: "/app/retention-height"})
+	require.NoError(t, err)
+	require.True(t, res.IsOK(), res.Log)
+
+	var info baseapp.BlockRetentionInfo
+	require.NoError(t, json.Unmarshal(res.Value, &info))
+	require.Equal(t, baseapp.BlockRetentionInfo{
+		CommitHeight:                   499000,
+		RetentionHeight:                99000,
+		EvidenceRetentionHeight:        136120,
+		SnapshotRetentionHeight:        349000,
+		MinRetainBlocksRetentionHeight: 99000,
+	}, info)
+}
+
 // Verifies that PrepareCheckState is called with the checkState.
 func TestPrepareCheckStateCalledWithCheckState(t *testing.T) {
 	t.Parallel()
@@ -1452,6 +3100,7 @@ func TestABCI_Proposal_HappyPath(t *testing.T) {
 	reqProcessProposal := abci.RequestProcessProposal{
 		Txs:    reqProposalTxBytes[:],
 		Height: reqPrepareProposal.Height,
+		Time:   time.Now(),
 	}
 
 	resProcessProposal, err := suite.baseApp.ProcessProposal(&reqProcessProposal)
@@ -1510,6 +3159,7 @@ func TestABCI_Proposal_Read_State_PrepareProposal(t *testing.T) {
 	reqProcessProposal := abci.RequestProcessProposal{
 		Txs:    reqProposalTxBytes,
 		Height: reqPrepareProposal.Height,
+		Time:   time.Now(),
 	}
 
 	resProcessProposal, err := suite.baseApp.ProcessProposal(&reqProcessProposal)
@@ -1536,48 +3186,283 @@ func TestABCI_Proposals_WithVE(t *testing.T) {
 			return &abci.ResponsePrepareProposal{Txs: txs}, nil
 		})
 
-		bapp.SetProcessProposal(func(ctx sdk.Context, req *abci.RequestProcessProposal) (*abci.ResponseProcessProposal, error) {
-			// Check that the vote extension is still there
-			require.Equal(t, someVoteExtension, req.Txs[0])
-			return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_ACCEPT}, nil
+		bapp.SetProcessProposal(func(ctx sdk.Context, req *abci.RequestProcessProposal) (*abci.ResponseProcessProposal, error) {
+			// Check that the vote extension is still there
+			require.Equal(t, someVoteExtension, req.Txs[0])
+			return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_ACCEPT}, nil
+		})
+	}
+
+	suite := NewBaseAppSuite(t, setInitChainerOpt, prepareOpt)
+
+	_, err := suite.baseApp.InitChain(&abci.RequestInitChain{
+		InitialHeight:   1,
+		ConsensusParams: &cmtproto.ConsensusParams{},
+	})
+	require.NoError(t, err)
+	reqPrepareProposal := abci.RequestPrepareProposal{
+		MaxTxBytes: 100000,
+		Height:     1, // this value can't be 0
+	}
+	resPrepareProposal, err := suite.baseApp.PrepareProposal(&reqPrepareProposal)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(resPrepareProposal.Txs))
+
+	reqProcessProposal := abci.RequestProcessProposal{
+		Txs:    resPrepareProposal.Txs,
+		Height: reqPrepareProposal.Height,
+		Time:   time.Now(),
+	}
+	resProcessProposal, err := suite.baseApp.ProcessProposal(&reqProcessProposal)
+	require.NoError(t, err)
+	require.Equal(t, abci.ResponseProcessProposal_ACCEPT, resProcessProposal.Status)
+
+	// Run finalize block and ensure that the vote extension is still there and that
+	// the proposal is accepted
+	result, err := suite.baseApp.FinalizeBlock(&abci.RequestFinalizeBlock{
+		Txs:    resPrepareProposal.Txs,
+		Height: reqPrepareProposal.Height,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(result.TxResults))
+	require.EqualValues(t, sdkerrors.ErrTxDecode.Codespace(), result.TxResults[0].Codespace, err)
+	require.EqualValues(t, sdkerrors.ErrTxDecode.ABCICode(), result.TxResults[0].Code, err)
+	require.EqualValues(t, 0, result.TxResults[0].GasUsed, err)
+	require.EqualValues(t, 0, result.TxResults[0].GasWanted, err)
+}
+
+func TestABCI_FinalizeBlock_StrictDecoding(t *testing.T) {
+	malformedTx := []byte("malformed tx")
+
+	testCases := map[string]struct {
+		strict bool
+	}{
+		"lenient": {strict: false},
+		"strict":  {strict: true},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			suite := NewBaseAppSuite(t, baseapp.SetStrictFinalizeBlockDecoding(tc.strict))
+			baseapptestutil.RegisterCounterServer(suite.baseApp.MsgServiceRouter(), CounterServerImplGasMeterOnly{})
+
+			_, err := suite.baseApp.InitChain(&abci.RequestInitChain{
+				ConsensusParams: &cmtproto.ConsensusParams{},
+			})
+			require.NoError(t, err)
+
+			txBytes, err := suite.txConfig.TxEncoder()(newTxCounter(t, suite.txConfig, 0, 0))
+			require.NoError(t, err)
+
+			res, err := suite.baseApp.FinalizeBlock(&abci.RequestFinalizeBlock{
+				Height: 1,
+				Txs:    [][]byte{txBytes, malformedTx},
+			})
+			if tc.strict {
+				require.ErrorIs(t, err, sdkerrors.ErrTxDecode)
+				require.ErrorContains(t, err, "failed to decode tx 1 of block 1")
+				require.Nil(t, res)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Len(t, res.TxResults, 2)
+			require.True(t, res.TxResults[0].IsOK(), res.TxResults[0].Log)
+			require.Equal(t, sdkerrors.ErrTxDecode.ABCICode(), res.TxResults[1].Code)
+		})
+	}
+}
+
+func TestABCI_FinalizeBlock_TxCountLimit(t *testing.T) {
+	suite := NewBaseAppSuite(t)
+
+	_, err := suite.baseApp.InitChain(&abci.RequestInitChain{
+		ConsensusParams: &cmtproto.ConsensusParams{
+			Block: &cmtproto.BlockParams{MaxBytes: 10},
+		},
+	})
+	require.NoError(t, err)
+
+	txs := func(n int) [][]byte {
+		txs := make([][]byte, n)
+		for i := range txs {
+			txs[i] = []byte{0x1}
+		}
+		return txs
+	}
+
+	// at most 5 txs fit in 10 bytes
+	_, err = suite.baseApp.FinalizeBlock(&abci.RequestFinalizeBlock{Height: 1, Txs: txs(6)})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	require.ErrorContains(t, err, "block 1 contains 6 txs, more than the 5 txs fitting in 10 bytes")
+
+	res, err := suite.baseApp.FinalizeBlock(&abci.RequestFinalizeBlock{Height: 1, Txs: txs(5)})
+	require.NoError(t, err)
+	require.Len(t, res.TxResults, 5)
+}
+
+func TestABCI_PrepareProposal_OversizedResponse(t *testing.T) {
+	// each tx takes 12 bytes once encoded in the block data
+	proposalTxs := [][]byte{
+		bytes.Repeat([]byte{1}, 10),
+		bytes.Repeat([]byte{2}, 10),
+		bytes.Repeat([]byte{3}, 10),
+	}
+	prepareOpt := func(bapp *baseapp.BaseApp) {
+		bapp.SetPrepareProposal(func(ctx sdk.Context, req *abci.RequestPrepareProposal) (*abci.ResponsePrepareProposal, error) {
+			return &abci.ResponsePrepareProposal{Txs: proposalTxs}, nil
+		})
+	}
+
+	testCases := map[string]struct {
+		reject   bool
+		maxBytes int64
+		expTxs   [][]byte
+		expErr   string
+	}{
+		"fitting proposal": {
+			maxBytes: 36,
+			expTxs:   proposalTxs,
+		},
+		"oversized proposal is trimmed": {
+			maxBytes: 35,
+			expTxs:   proposalTxs[:2],
+		},
+		"fitting proposal is accepted when rejecting": {
+			reject:   true,
+			maxBytes: 36,
+			expTxs:   proposalTxs,
+		},
+		"oversized proposal is rejected": {
+			reject:   true,
+			maxBytes: 35,
+			expErr:   "prepared proposal at height 1 exceeds max tx bytes 35 at tx 2 of 3",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			suite := NewBaseAppSuite(t, prepareOpt, baseapp.SetRejectOversizedProposals(tc.reject))
+
+			_, err := suite.baseApp.InitChain(&abci.RequestInitChain{
+				ConsensusParams: &cmtproto.ConsensusParams{},
+			})
+			require.NoError(t, err)
+
+			res, err := suite.baseApp.PrepareProposal(&abci.RequestPrepareProposal{
+				Height:     1,
+				MaxTxBytes: tc.maxBytes,
+			})
+			if tc.expErr != "" {
+				require.EqualError(t, err, tc.expErr)
+				require.Nil(t, res)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expTxs, res.Txs)
+			if len(tc.expTxs) < len(proposalTxs) {
+				require.Contains(t, suite.logBuffer.String(), "trimming prepared proposal exceeding max tx bytes")
+			} else {
+				require.NotContains(t, suite.logBuffer.String(), "trimming prepared proposal")
+			}
+		})
+	}
+}
+
+func TestABCI_PrepareProposal_MaxTxBytesHandler(t *testing.T) {
+	// each tx takes 12 bytes once encoded in the block data
+	txs := [][]byte{
+		bytes.Repeat([]byte{1}, 10),
+		bytes.Repeat([]byte{2}, 10),
+		bytes.Repeat([]byte{3}, 10),
+	}
+
+	testCases := map[string]struct {
+		panics   bool
+		maxBytes int64
+		expTxs   [][]byte
+	}{
+		"all txs fit": {
+			maxBytes: 36,
+			expTxs:   txs,
+		},
+		"unbounded max bytes": {
+			maxBytes: 0,
+			expTxs:   txs,
+		},
+		"txs are trimmed": {
+			maxBytes: 35,
+			expTxs:   txs[:2],
+		},
+		"first tx does not fit": {
+			maxBytes: 11,
+			expTxs:   [][]byte{},
+		},
+		"panic fallback is trimmed": {
+			panics:   true,
+			maxBytes: 25,
+			expTxs:   txs[:2],
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			handler := baseapp.MaxTxBytesPrepareProposal()
+			prepareOpt := func(bapp *baseapp.BaseApp) {
+				bapp.SetPrepareProposal(func(ctx sdk.Context, req *abci.RequestPrepareProposal) (*abci.ResponsePrepareProposal, error) {
+					if tc.panics {
+						panic("prepare proposal panic")
+					}
+					return handler(ctx, req)
+				})
+			}
+			suite := NewBaseAppSuite(t, prepareOpt)
+
+			_, err := suite.baseApp.InitChain(&abci.RequestInitChain{
+				ConsensusParams: &cmtproto.ConsensusParams{},
+			})
+			require.NoError(t, err)
+
+			res, err := suite.baseApp.PrepareProposal(&abci.RequestPrepareProposal{
+				Height:     1,
+				MaxTxBytes: tc.maxBytes,
+				Txs:        txs,
+			})
+			require.NoError(t, err)
+			require.Equal(t, tc.expTxs, res.Txs)
+			require.NotContains(t, suite.logBuffer.String(), "trimming prepared proposal")
 		})
 	}
+}
 
-	suite := NewBaseAppSuite(t, setInitChainerOpt, prepareOpt)
-
-	_, err := suite.baseApp.InitChain(&abci.RequestInitChain{
-		InitialHeight:   1,
-		ConsensusParams: &cmtproto.ConsensusParams{},
-	})
-	require.NoError(t, err)
-	reqPrepareProposal := abci.RequestPrepareProposal{
-		MaxTxBytes: 100000,
-		Height:     1, // this value can't be 0
+func TestABCI_PrepareProposal_MaxTxBytesTrimmingPolicy(t *testing.T) {
+	// the txs take 12, 32 and 12 bytes once encoded in the block data, so the
+	// last tx would fit in 36 bytes after leaving out the second one
+	txs := [][]byte{
+		bytes.Repeat([]byte{1}, 10),
+		bytes.Repeat([]byte{2}, 30),
+		bytes.Repeat([]byte{3}, 10),
 	}
-	resPrepareProposal, err := suite.baseApp.PrepareProposal(&reqPrepareProposal)
-	require.NoError(t, err)
-	require.Equal(t, 1, len(resPrepareProposal.Txs))
+	req := &abci.RequestPrepareProposal{Height: 1, MaxTxBytes: 36, Txs: txs}
+
+	// both the handler and the trimming of oversized proposals keep the txs
+	// preceding the first one that does not fit
+	for _, handler := range []sdk.PrepareProposalHandler{
+		baseapp.MaxTxBytesPrepareProposal(),
+		baseapp.NoOpPrepareProposal(),
+	} {
+		suite := NewBaseAppSuite(t, func(bapp *baseapp.BaseApp) { bapp.SetPrepareProposal(handler) })
+
+		_, err := suite.baseApp.InitChain(&abci.RequestInitChain{
+			ConsensusParams: &cmtproto.ConsensusParams{},
+		})
+		require.NoError(t, err)
 
-	reqProcessProposal := abci.RequestProcessProposal{
-		Txs:    resPrepareProposal.Txs,
-		Height: reqPrepareProposal.Height,
+		res, err := suite.baseApp.PrepareProposal(req)
+		require.NoError(t, err)
+		require.Equal(t, txs[:1], res.Txs)
 	}
-	resProcessProposal, err := suite.baseApp.ProcessProposal(&reqProcessProposal)
-	require.NoError(t, err)
-	require.Equal(t, abci.ResponseProcessProposal_ACCEPT, resProcessProposal.Status)
-
-	// Run finalize block and ensure that the vote extension is still there and that
-	// the proposal is accepted
-	result, err := suite.baseApp.FinalizeBlock(&abci.RequestFinalizeBlock{
-		Txs:    resPrepareProposal.Txs,
-		Height: reqPrepareProposal.Height,
-	})
-	require.NoError(t, err)
-	require.Equal(t, 1, len(result.TxResults))
-	require.EqualValues(t, sdkerrors.ErrTxDecode.Codespace(), result.TxResults[0].Codespace, err)
-	require.EqualValues(t, sdkerrors.ErrTxDecode.ABCICode(), result.TxResults[0].Code, err)
-	require.EqualValues(t, 0, result.TxResults[0].GasUsed, err)
-	require.EqualValues(t, 0, result.TxResults[0].GasWanted, err)
 }
 
 func TestABCI_PrepareProposal_ReachedMaxBytes(t *testing.T) {
@@ -1926,12 +3811,123 @@ func TestABCI_ProcessProposal_PanicRecovery(t *testing.T) {
 	require.NoError(t, err)
 
 	require.NotPanics(t, func() {
-		res, err := suite.baseApp.ProcessProposal(&abci.RequestProcessProposal{Height: 1})
+		res, err := suite.baseApp.ProcessProposal(&abci.RequestProcessProposal{Height: 1, Time: time.Now()})
 		require.NoError(t, err)
 		require.Equal(t, res.Status, abci.ResponseProcessProposal_REJECT)
 	})
 }
 
+func TestABCI_ProcessProposal_ValidateProposerAddress(t *testing.T) {
+	suite := NewBaseAppSuite(t, baseapp.SetValidateProposerAddress(true))
+
+	_, err := suite.baseApp.InitChain(&abci.RequestInitChain{
+		ConsensusParams: &cmtproto.ConsensusParams{},
+	})
+	require.NoError(t, err)
+
+	res, err := suite.baseApp.ProcessProposal(&abci.RequestProcessProposal{
+		Height:          1,
+		Time:            time.Now(),
+		ProposerAddress: []byte{1, 2, 3},
+	})
+	require.NoError(t, err)
+	require.Equal(t, abci.ResponseProcessProposal_REJECT, res.Status)
+
+	res, err = suite.baseApp.ProcessProposal(&abci.RequestProcessProposal{
+		Height:          1,
+		Time:            time.Now(),
+		ProposerAddress: bytes.Repeat([]byte{1}, 20),
+	})
+	require.NoError(t, err)
+	require.Equal(t, abci.ResponseProcessProposal_ACCEPT, res.Status)
+}
+
+func TestABCI_ProcessProposal_ZeroTime(t *testing.T) {
+	suite := NewBaseAppSuite(t)
+
+	_, err := suite.baseApp.InitChain(&abci.RequestInitChain{
+		ConsensusParams: &cmtproto.ConsensusParams{},
+	})
+	require.NoError(t, err)
+
+	res, err := suite.baseApp.ProcessProposal(&abci.RequestProcessProposal{Height: 1})
+	require.NoError(t, err)
+	require.Equal(t, abci.ResponseProcessProposal_REJECT, res.Status)
+	require.Contains(t, suite.logBuffer.String(), "rejecting proposal with zero block time")
+
+	res, err = suite.baseApp.ProcessProposal(&abci.RequestProcessProposal{Height: 1, Time: time.Now()})
+	require.NoError(t, err)
+	require.Equal(t, abci.ResponseProcessProposal_ACCEPT, res.Status)
+}
+
+func TestABCI_ProcessProposal_RejectionReason(t *testing.T) {
+	proposer := bytes.Repeat([]byte{0xAB}, 20)
+	processOpt := func(bapp *baseapp.BaseApp) {
+		bapp.SetProcessProposal(func(ctx sdk.Context, req *abci.RequestProcessProposal) (*abci.ResponseProcessProposal, error) {
+			if len(req.Txs) > 0 {
+				return baseapp.RejectProposalWithReason("unexpected txs in proposal")
+			}
+			return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_ACCEPT}, nil
+		})
+	}
+	suite := NewBaseAppSuite(t, processOpt)
+
+	_, err := suite.baseApp.InitChain(&abci.RequestInitChain{
+		ConsensusParams: &cmtproto.ConsensusParams{},
+	})
+	require.NoError(t, err)
+
+	res, err := suite.baseApp.ProcessProposal(&abci.RequestProcessProposal{
+		Height:          1,
+		Time:            time.Now(),
+		ProposerAddress: proposer,
+		Txs:             [][]byte{[]byte("tx")},
+	})
+	require.NoError(t, err)
+	require.Equal(t, abci.ResponseProcessProposal_REJECT, res.Status)
+
+	logs := suite.logBuffer.String()
+	require.Contains(t, logs, "rejected proposal")
+	require.Contains(t, logs, "reason=\"unexpected txs in proposal\"")
+	require.Contains(t, logs, "height=1")
+	require.Contains(t, logs, fmt.Sprintf("proposer=%X", proposer))
+}
+
+func TestABCI_PrepareProposal_MaxTimeSkew(t *testing.T) {
+	const skew = time.Minute
+
+	var handlerTime time.Time
+	prepareOpt := func(bapp *baseapp.BaseApp) {
+		bapp.SetPrepareProposal(func(ctx sdk.Context, req *abci.RequestPrepareProposal) (*abci.ResponsePrepareProposal, error) {
+			handlerTime = ctx.HeaderInfo().Time
+			require.Equal(t, handlerTime, ctx.BlockTime())
+			return &abci.ResponsePrepareProposal{Txs: req.Txs}, nil
+		})
+	}
+	suite := NewBaseAppSuite(t, prepareOpt, baseapp.SetMaxProposalTimeSkew(skew))
+
+	_, err := suite.baseApp.InitChain(&abci.RequestInitChain{
+		ConsensusParams: &cmtproto.ConsensusParams{},
+	})
+	require.NoError(t, err)
+
+	// a time within the tolerated skew is kept
+	reqTime := time.Now().Add(skew / 2)
+	_, err = suite.baseApp.PrepareProposal(&abci.RequestPrepareProposal{Height: 1, Time: reqTime})
+	require.NoError(t, err)
+	require.True(t, reqTime.Equal(handlerTime))
+	require.NotContains(t, suite.logBuffer.String(), "clamping proposal time")
+
+	// an excessive future skew is clamped to the local clock plus the skew
+	before := time.Now()
+	_, err = suite.baseApp.PrepareProposal(&abci.RequestPrepareProposal{Height: 1, Time: before.Add(time.Hour)})
+	require.NoError(t, err)
+	after := time.Now()
+	require.False(t, handlerTime.Before(before.Add(skew)))
+	require.False(t, handlerTime.After(after.Add(skew)))
+	require.Contains(t, suite.logBuffer.String(), "clamping proposal time too far ahead of the local clock")
+}
+
 // TestABCI_Proposal_Reset_State ensures that state is reset between runs of
 // PrepareProposal and ProcessProposal in case they are called multiple times.
 // This is only valid for heights > 1, given that on height 1 we always set the
@@ -1981,6 +3977,7 @@ func TestABCI_Proposal_Reset_State_Between_Calls(t *testing.T) {
 	reqProcessProposal := abci.RequestProcessProposal{
 		Txs:    reqProposalTxBytes,
 		Height: 2,
+		Time:   time.Now(),
 	}
 
 	// Let's pretend something happened and ProcessProposal gets called many
@@ -2031,6 +4028,89 @@ func TestABCI_HaltChain(t *testing.T) {
 	}
 }
 
+func TestABCI_HaltChain_LogEvaluation(t *testing.T) {
+	finalizeBlock := func(suite *BaseAppSuite, height int64) error {
+		_, err := suite.baseApp.FinalizeBlock(&abci.RequestFinalizeBlock{
+			Height: height,
+			Time:   time.Unix(1, 0),
+		})
+		return err
+	}
+
+	// evaluations are not logged by default
+	suite := NewBaseAppSuite(t, baseapp.SetHaltHeight(10))
+	_, err := suite.baseApp.InitChain(&abci.RequestInitChain{InitialHeight: 10})
+	require.NoError(t, err)
+	require.NoError(t, finalizeBlock(suite, 10))
+	require.NotContains(t, suite.logBuffer.String(), "evaluated halt conditions")
+
+	// at the halt height the evaluation is logged without halting
+	suite = NewBaseAppSuite(t, baseapp.SetHaltHeight(10), baseapp.SetLogHaltEvaluation(true))
+	_, err = suite.baseApp.InitChain(&abci.RequestInitChain{InitialHeight: 10})
+	require.NoError(t, err)
+	require.NoError(t, finalizeBlock(suite, 10))
+
+	logs := suite.logBuffer.String()
+	require.Contains(t, logs, "evaluated halt conditions")
+	require.Contains(t, logs, "block_time=1 halt=false halt_height=10 halt_time=0 height=10")
+
+	// past the halt height the evaluation is logged before halting
+	suite.logBuffer.Reset()
+	require.Error(t, finalizeBlock(suite, 11))
+	require.Contains(t, suite.logBuffer.String(), "block_time=1 halt=true halt_height=10 halt_time=0 height=11")
+}
+
+func TestABCI_HaltChain_GracePeriod(t *testing.T) {
+	const gracePeriod = 200 * time.Millisecond
+
+	suite := NewBaseAppSuite(t, baseapp.SetHaltHeight(10), baseapp.SetHaltGracePeriod(gracePeriod))
+	_, err := suite.baseApp.InitChain(&abci.RequestInitChain{InitialHeight: 10})
+	require.NoError(t, err)
+
+	// the halt height itself is executed without delay
+	start := time.Now()
+	_, err = suite.baseApp.FinalizeBlock(&abci.RequestFinalizeBlock{Height: 10, Time: time.Unix(1, 0)})
+	require.NoError(t, err)
+	require.Less(t, time.Since(start), gracePeriod)
+	require.NotContains(t, suite.logBuffer.String(), "node will halt after the grace period")
+
+	// past the halt height the node halts once the grace period elapsed
+	start = time.Now()
+	_, err = suite.baseApp.FinalizeBlock(&abci.RequestFinalizeBlock{Height: 11, Time: time.Unix(1, 0)})
+	require.ErrorContains(t, err, "halt per configuration")
+	require.GreaterOrEqual(t, time.Since(start), gracePeriod)
+	require.Contains(t, suite.logBuffer.String(), "halt conditions reached; node will halt after the grace period")
+}
+
+func TestABCI_HaltChain_Clock(t *testing.T) {
+	const gracePeriod = time.Hour
+
+	start := time.Unix(1000, 0)
+	clock := newFakeClock(start)
+	suite := NewBaseAppSuite(t,
+		baseapp.SetHaltTime(100),
+		baseapp.SetHaltGracePeriod(gracePeriod),
+		baseapp.SetClock(clock),
+	)
+	_, err := suite.baseApp.InitChain(&abci.RequestInitChain{})
+	require.NoError(t, err)
+
+	// a block at the halt time is executed without waiting
+	_, err = suite.baseApp.FinalizeBlock(&abci.RequestFinalizeBlock{Height: 1, Time: time.Unix(100, 0)})
+	require.NoError(t, err)
+	require.Equal(t, start, clock.Now())
+	_, err = suite.baseApp.Commit()
+	require.NoError(t, err)
+
+	// past the halt time, the node halts after the grace period elapsed on
+	// the clock, without actually sleeping
+	wallStart := time.Now()
+	_, err = suite.baseApp.FinalizeBlock(&abci.RequestFinalizeBlock{Height: 2, Time: time.Unix(101, 0)})
+	require.ErrorContains(t, err, "halt per configuration")
+	require.Equal(t, start.Add(gracePeriod), clock.Now())
+	require.Less(t, time.Since(wallStart), gracePeriod)
+}
+
 func TestBaseApp_PreBlocker(t *testing.T) {
 	db := dbm.NewMemDB()
 	name := t.Name()
@@ -2067,6 +4147,44 @@ func TestBaseApp_PreBlocker(t *testing.T) {
 	require.Error(t, err)
 }
 
+// TestBaseApp_PreBlockerConsensusParamsChange covers the consensus params and
+// block gas meter being refreshed when a preblocker changes the params.
+func TestBaseApp_PreBlockerConsensusParamsChange(t *testing.T) {
+	var beginBlockGasLimit uint64
+	suite := NewBaseAppSuite(t, func(app *baseapp.BaseApp) {
+		app.SetPreBlocker(func(ctx sdk.Context, req *abci.RequestFinalizeBlock) (*sdk.ResponsePreBlock, error) {
+			cp := app.GetConsensusParams(ctx)
+			cp.Block.MaxGas = 2000000
+			if err := app.StoreConsensusParams(ctx, cp); err != nil {
+				return nil, err
+			}
+			return &sdk.ResponsePreBlock{ConsensusParamsChanged: true}, nil
+		})
+		app.SetBeginBlocker(func(ctx sdk.Context) (sdk.BeginBlock, error) {
+			beginBlockGasLimit = ctx.BlockGasMeter().Limit()
+			return sdk.BeginBlock{}, nil
+		})
+	})
+
+	_, err := suite.baseApp.InitChain(&abci.RequestInitChain{
+		ConsensusParams: &cmtproto.ConsensusParams{
+			Block: &cmtproto.BlockParams{MaxGas: 1000000},
+		},
+	})
+	require.NoError(t, err)
+
+	_, err = suite.baseApp.FinalizeBlock(&abci.RequestFinalizeBlock{Height: 1})
+	require.NoError(t, err)
+	require.Equal(t, uint64(2000000), beginBlockGasLimit)
+
+	// check state is not updated mid-block, as CheckTx may run concurrently,
+	// but picks up the new params once it is reset on Commit
+	_, err = suite.baseApp.Commit()
+	require.NoError(t, err)
+	checkCtx := suite.baseApp.GetContextForCheckTx(nil)
+	require.Equal(t, int64(2000000), checkCtx.ConsensusParams().Block.MaxGas)
+}
+
 // TestBaseApp_VoteExtensions tests vote extensions using a price as an example.
 func TestBaseApp_VoteExtensions(t *testing.T) {
 	ctrl := gomock.NewController(t)
@@ -2257,7 +4375,7 @@ func TestBaseApp_VoteExtensions(t *testing.T) {
 	require.NoError(t, err)
 	require.Len(t, resp.Txs, 0)
 
-	procPropRes, err := suite.baseApp.ProcessProposal(&abci.RequestProcessProposal{Height: 1, Txs: resp.Txs})
+	procPropRes, err := suite.baseApp.ProcessProposal(&abci.RequestProcessProposal{Height: 1, Txs: resp.Txs, Time: time.Now()})
 	require.NoError(t, err)
 	require.Equal(t, abci.ResponseProcessProposal_ACCEPT, procPropRes.Status)
 
@@ -2304,7 +4422,7 @@ func TestBaseApp_VoteExtensions(t *testing.T) {
 	require.NoError(t, err)
 	require.Len(t, resp.Txs, 10)
 
-	procPropRes, err = suite.baseApp.ProcessProposal(&abci.RequestProcessProposal{Height: 2, Txs: resp.Txs})
+	procPropRes, err = suite.baseApp.ProcessProposal(&abci.RequestProcessProposal{Height: 2, Txs: resp.Txs, Time: time.Now()})
 	require.NoError(t, err)
 	require.Equal(t, abci.ResponseProcessProposal_ACCEPT, procPropRes.Status)
 
@@ -2379,6 +4497,7 @@ func TestOptimisticExecution(t *testing.T) {
 		reqProcProp := abci.RequestProcessProposal{
 			Txs:    [][]byte{txBytes},
 			Height: suite.baseApp.LastBlockHeight() + 1,
+			Time:   time.Now(),
 			Hash:   []byte("some-hash" + strconv.FormatInt(suite.baseApp.LastBlockHeight()+1, 10)),
 		}
 
@@ -2402,3 +4521,228 @@ func TestOptimisticExecution(t *testing.T) {
 
 	require.Equal(t, int64(50), suite.baseApp.LastBlockHeight())
 }
+
+func TestABCI_FinalizeBlock_AppHashEvent(t *testing.T) {
+	suite := NewBaseAppSuite(t, baseapp.SetAppHashEvent(true))
+
+	_, err := suite.baseApp.InitChain(&abci.RequestInitChain{
+		ConsensusParams: &cmtproto.ConsensusParams{},
+	})
+	require.NoError(t, err)
+
+	for height := int64(1); height <= 2; height++ {
+		res, err := suite.baseApp.FinalizeBlock(&abci.RequestFinalizeBlock{Height: height})
+		require.NoError(t, err)
+		require.NotEmpty(t, res.AppHash)
+
+		var events []abci.Event
+		for _, event := range res.Events {
+			if event.Type == baseapp.EventTypeAppHash {
+				events = append(events, event)
+			}
+		}
+		require.Len(t, events, 1)
+		require.Equal(t, []abci.EventAttribute{
+			{Key: baseapp.AttributeKeyHeight, Value: strconv.FormatInt(height, 10), Index: true},
+			{Key: baseapp.AttributeKeyAppHash, Value: fmt.Sprintf("%X", res.AppHash), Index: true},
+		}, events[0].Attributes)
+
+		commitRes, err := suite.baseApp.Commit()
+		require.NoError(t, err)
+		require.NotNil(t, commitRes)
+		require.Equal(t, res.AppHash, suite.baseApp.LastCommitID().Hash)
+	}
+}
+
+func TestOptimisticExecution_Telemetry(t *testing.T) {
+	sink := metrics.NewInmemSink(time.Hour, time.Hour)
+	cfg := metrics.DefaultConfig("test")
+	cfg.EnableHostname = false
+	cfg.EnableRuntimeMetrics = false
+	_, err := metrics.NewGlobal(cfg, sink)
+	require.NoError(t, err)
+	t.Cleanup(func() { _, _ = metrics.NewGlobal(metrics.DefaultConfig(""), &metrics.BlackholeSink{}) })
+
+	suite := NewBaseAppSuite(t, baseapp.SetOptimisticExecution())
+
+	_, err = suite.baseApp.InitChain(&abci.RequestInitChain{
+		ConsensusParams: &cmtproto.ConsensusParams{},
+	})
+	require.NoError(t, err)
+
+	// the first block is never executed optimistically
+	_, err = suite.baseApp.FinalizeBlock(&abci.RequestFinalizeBlock{Height: 1})
+	require.NoError(t, err)
+	_, err = suite.baseApp.Commit()
+	require.NoError(t, err)
+
+	// height 2 finalizes the optimistically executed proposal, height 3 a
+	// different one
+	for _, tc := range []struct {
+		height        int64
+		finalizedHash []byte
+	}{
+		{height: 2, finalizedHash: []byte("hash-2")},
+		{height: 3, finalizedHash: []byte("other-hash")},
+	} {
+		height := tc.height
+		respProcProp, err := suite.baseApp.ProcessProposal(&abci.RequestProcessProposal{
+			Height: height,
+			Time:   time.Now(),
+			Hash:   []byte("hash-" + strconv.FormatInt(height, 10)),
+		})
+		require.NoError(t, err)
+		require.Equal(t, abci.ResponseProcessProposal_ACCEPT, respProcProp.Status)
+
+		_, err = suite.baseApp.FinalizeBlock(&abci.RequestFinalizeBlock{Height: height, Hash: tc.finalizedHash})
+		require.NoError(t, err)
+		_, err = suite.baseApp.Commit()
+		require.NoError(t, err)
+	}
+
+	data := sink.Data()
+	require.NotEmpty(t, data)
+	counters := data[0].Counters
+
+	counter := func(outcome string, height int64) int {
+		c, ok := counters["test.optimistic_execution."+outcome+";height="+strconv.FormatInt(height, 10)]
+		if !ok {
+			return 0
+		}
+		return c.Count
+	}
+	require.Equal(t, 1, counter("started", 2))
+	require.Equal(t, 1, counter("useful", 2))
+	require.Equal(t, 0, counter("aborted", 2))
+	require.Equal(t, 1, counter("started", 3))
+	require.Equal(t, 0, counter("useful", 3))
+	require.Equal(t, 1, counter("aborted", 3))
+}
+
+func TestOptimisticExecution_ProposerFilter(t *testing.T) {
+	flakyProposer := bytes.Repeat([]byte{0x01}, 20)
+	goodProposer := bytes.Repeat([]byte{0x02}, 20)
+	deliverKey := []byte("deliver-key")
+
+	suite := NewBaseAppSuite(t,
+		baseapp.SetOptimisticExecution(),
+		baseapp.SetOptimisticExecutionFilter(func(proposer []byte) bool {
+			return !bytes.Equal(proposer, flakyProposer)
+		}),
+	)
+	baseapptestutil.RegisterCounterServer(suite.baseApp.MsgServiceRouter(), CounterServerImpl{t, capKey1, deliverKey})
+
+	// the reference app executes every block synchronously
+	refSuite := NewBaseAppSuite(t)
+	baseapptestutil.RegisterCounterServer(refSuite.baseApp.MsgServiceRouter(), CounterServerImpl{t, capKey1, deliverKey})
+
+	for _, app := range []*baseapp.BaseApp{suite.baseApp, refSuite.baseApp} {
+		_, err := app.InitChain(&abci.RequestInitChain{
+			ConsensusParams: &cmtproto.ConsensusParams{},
+		})
+		require.NoError(t, err)
+	}
+
+	proposers := [][]byte{goodProposer, flakyProposer, goodProposer, flakyProposer, flakyProposer}
+	for i, proposer := range proposers {
+		tx := newTxCounter(t, suite.txConfig, int64(i), int64(i))
+		txBytes, err := suite.txConfig.TxEncoder()(tx)
+		require.NoError(t, err)
+
+		height := int64(i + 1)
+		hash := []byte("some-hash" + strconv.FormatInt(height, 10))
+		for _, app := range []*baseapp.BaseApp{suite.baseApp, refSuite.baseApp} {
+			respProcProp, err := app.ProcessProposal(&abci.RequestProcessProposal{
+				Txs:             [][]byte{txBytes},
+				Height:          height,
+				Time:            time.Now(),
+				Hash:            hash,
+				ProposerAddress: proposer,
+			})
+			require.NoError(t, err)
+			require.Equal(t, abci.ResponseProcessProposal_ACCEPT, respProcProp.Status)
+
+			res, err := app.FinalizeBlock(&abci.RequestFinalizeBlock{
+				Txs:             [][]byte{txBytes},
+				Height:          height,
+				Hash:            hash,
+				ProposerAddress: proposer,
+			})
+			require.NoError(t, err)
+			require.Len(t, res.TxResults, 1)
+
+			_, err = app.Commit()
+			require.NoError(t, err)
+		}
+
+		require.Equal(t, refSuite.baseApp.LastCommitID(), suite.baseApp.LastCommitID())
+	}
+
+	// only the good proposer's block past the initial height was executed
+	// optimistically
+	require.Equal(t, 1, strings.Count(suite.logBuffer.String(), "OE started"))
+}
+
+func TestOptimisticExecution_AbortCancelsTx(t *testing.T) {
+	suite := NewBaseAppSuite(t, baseapp.SetOptimisticExecution())
+
+	blockingKey := []byte("blocking-key")
+	block := &atomic.Bool{}
+	srv := BlockingCounterServerImpl{
+		capKey:  capKey1,
+		key:     blockingKey,
+		block:   block,
+		started: make(chan struct{}),
+		result:  make(chan error, 1),
+	}
+	baseapptestutil.RegisterCounterServer(suite.baseApp.MsgServiceRouter(), srv)
+
+	_, err := suite.baseApp.InitChain(&abci.RequestInitChain{
+		ConsensusParams: &cmtproto.ConsensusParams{},
+	})
+	require.NoError(t, err)
+
+	// the first block is never executed optimistically
+	_, err = suite.baseApp.FinalizeBlock(&abci.RequestFinalizeBlock{Height: 1})
+	require.NoError(t, err)
+	_, err = suite.baseApp.Commit()
+	require.NoError(t, err)
+
+	tx := newTxCounter(t, suite.txConfig, 0, 1)
+	txBytes, err := suite.txConfig.TxEncoder()(tx)
+	require.NoError(t, err)
+
+	block.Store(true)
+	respProcProp, err := suite.baseApp.ProcessProposal(&abci.RequestProcessProposal{
+		Txs:    [][]byte{txBytes},
+		Height: 2,
+		Time:   time.Now(),
+		Hash:   []byte("proposal-hash"),
+	})
+	require.NoError(t, err)
+	require.Equal(t, abci.ResponseProcessProposal_ACCEPT, respProcProp.Status)
+
+	select {
+	case <-srv.started:
+	case <-time.After(10 * time.Second):
+		t.Fatal("optimistic execution did not start the tx")
+	}
+
+	// finalizing a different block aborts the optimistic execution, which must
+	// interrupt the blocked message handler
+	start := time.Now()
+	_, err = suite.baseApp.FinalizeBlock(&abci.RequestFinalizeBlock{
+		Height: 2,
+		Hash:   []byte("other-hash"),
+	})
+	require.NoError(t, err)
+	require.ErrorIs(t, <-srv.result, context.Canceled)
+	require.Less(t, time.Since(start), 5*time.Second)
+
+	_, err = suite.baseApp.Commit()
+	require.NoError(t, err)
+
+	// the writes of the cancelled tx are discarded
+	store := suite.baseApp.CommitMultiStore().GetKVStore(capKey1)
+	require.Nil(t, store.Get(blockingKey))
+}
@@ -191,6 +191,37 @@ func validateExtendedCommitAgainstLastCommit(ec abci.ExtendedCommitInfo, lc come
 	return nil
 }
 
+// VoteExtensionTxMatcher reports whether a raw proposal tx is data injected by
+// the proposer, such as vote extensions, rather than a tx to be decoded.
+type VoteExtensionTxMatcher func(txBz []byte) bool
+
+// VoteExtensionTxPrefix returns a VoteExtensionTxMatcher matching the raw txs
+// starting with the given prefix.
+func VoteExtensionTxPrefix(prefix []byte) VoteExtensionTxMatcher {
+	return func(txBz []byte) bool {
+		return bytes.HasPrefix(txBz, prefix)
+	}
+}
+
+// ValidateProposalTxsDecode defines a helper function for ProcessProposal
+// handlers that rejects a proposal if any of its txs fails to decode. Txs
+// matched by isVoteExtension are skipped, as they are not expected to decode;
+// if it is nil, every tx must decode. Note that FinalizeBlock tolerates
+// undecodable txs, so rejecting them is up to the application.
+func ValidateProposalTxsDecode(txVerifier ProposalTxVerifier, txs [][]byte, isVoteExtension VoteExtensionTxMatcher) error {
+	for i, txBz := range txs {
+		if isVoteExtension != nil && isVoteExtension(txBz) {
+			continue
+		}
+
+		if _, err := txVerifier.TxDecode(txBz); err != nil {
+			return fmt.Errorf("failed to decode proposal tx %d: %w", i, err)
+		}
+	}
+
+	return nil
+}
+
 type (
 	// ProposalTxVerifier defines the interface that is implemented by BaseApp,
 	// that any custom ABCI PrepareProposal and ProcessProposal handler can use
@@ -382,7 +413,7 @@ func (h *DefaultProposalHandler) ProcessProposalHandler() sdk.ProcessProposalHan
 		for _, txBytes := range req.Txs {
 			tx, err := h.txVerifier.ProcessProposalVerifyTx(txBytes)
 			if err != nil {
-				return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_REJECT}, nil
+				return rejectProposal(ctx, req, fmt.Sprintf("invalid tx: %s", err)), nil
 			}
 
 			if maxBlockGas > 0 {
@@ -392,7 +423,7 @@ func (h *DefaultProposalHandler) ProcessProposalHandler() sdk.ProcessProposalHan
 				}
 
 				if totalTxGas > uint64(maxBlockGas) {
-					return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_REJECT}, nil
+					return rejectProposal(ctx, req, fmt.Sprintf("txs gas %d exceeds max block gas %d", totalTxGas, maxBlockGas)), nil
 				}
 			}
 		}
@@ -401,6 +432,40 @@ func (h *DefaultProposalHandler) ProcessProposalHandler() sdk.ProcessProposalHan
 	}
 }
 
+// rejectProposal logs the reason for rejecting the given proposal and returns
+// a REJECT response.
+func rejectProposal(ctx sdk.Context, req *abci.RequestProcessProposal, reason string) *abci.ResponseProcessProposal {
+	ctx.Logger().Info(
+		"rejected proposal",
+		"height", req.Height,
+		"proposer", fmt.Sprintf("%X", req.ProposerAddress),
+		"hash", fmt.Sprintf("%X", req.Hash),
+		"reason", reason,
+	)
+
+	return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_REJECT}
+}
+
+// ProposalRejection is the error returned along with a REJECT response by
+// RejectProposalWithReason. BaseApp logs its reason together with the height
+// and proposer of the rejected proposal. The default ProcessProposal handler
+// does not return it, it logs its reasons itself and returns a nil error.
+type ProposalRejection struct {
+	Reason string
+}
+
+func (r ProposalRejection) Error() string {
+	return fmt.Sprintf("proposal rejected: %s", r.Reason)
+}
+
+// RejectProposalWithReason returns a REJECT response for a ProcessProposal
+// handler, attaching a machine-readable reason for the rejection. It is opt-in
+// for custom handlers: wrappers of such handlers must not treat the returned
+// error as a failure to process the proposal.
+func RejectProposalWithReason(reason string) (*abci.ResponseProcessProposal, error) {
+	return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_REJECT}, ProposalRejection{Reason: reason}
+}
+
 // NoOpPrepareProposal defines a no-op PrepareProposal handler. It will always
 // return the transactions sent by the client's request.
 func NoOpPrepareProposal() sdk.PrepareProposalHandler {
@@ -409,6 +474,36 @@ func NoOpPrepareProposal() sdk.PrepareProposalHandler {
 	}
 }
 
+// MaxTxBytesPrepareProposal defines a PrepareProposal handler for chains that
+// do not maintain an app-side mempool. It passes the transactions provided by
+// CometBFT through in order, trimmed from the end to fit in the request's
+// MaxTxBytes, without decoding them.
+func MaxTxBytesPrepareProposal() sdk.PrepareProposalHandler {
+	return func(ctx sdk.Context, req *abci.RequestPrepareProposal) (*abci.ResponsePrepareProposal, error) {
+		return &abci.ResponsePrepareProposal{Txs: req.Txs[:fittingTxsCount(req.Txs, req.MaxTxBytes)]}, nil
+	}
+}
+
+// fittingTxsCount returns the number of leading txs fitting in maxTxBytes. Txs
+// are trimmed from the end so that the proposal order is kept: once a tx does
+// not fit, it and all the following txs are left out, even if smaller ones
+// would fit. All txs fit if maxTxBytes is non-positive.
+func fittingTxsCount(txs [][]byte, maxTxBytes int64) int {
+	if maxTxBytes <= 0 || cmttypes.ComputeProtoSizeForTxs(cmttypes.ToTxs(txs)) <= maxTxBytes {
+		return len(txs)
+	}
+
+	var totalBytes int64
+	for i, tx := range txs {
+		totalBytes += cmttypes.ComputeProtoSizeForTxs([]cmttypes.Tx{tx})
+		if totalBytes > maxTxBytes {
+			return i
+		}
+	}
+
+	return len(txs)
+}
+
 // NoOpProcessProposal defines a no-op ProcessProposal Handler. It will always
 // return ACCEPT.
 func NoOpProcessProposal() sdk.ProcessProposalHandler {
@@ -2,6 +2,7 @@ package baseapp_test
 
 import (
 	"bytes"
+	"errors"
 	"sort"
 	"testing"
 
@@ -705,6 +706,87 @@ func (s *ABCIUtilsTestSuite) TestDefaultProposalHandler_PriorityNonceMempoolTxSe
 	}
 }
 
+func (s *ABCIUtilsTestSuite) TestDefaultProposalHandler_ProcessProposalRejection() {
+	ctrl := gomock.NewController(s.T())
+	app := mock.NewMockProposalTxVerifier(ctrl)
+	mp := mempool.NewPriorityMempool(mempool.DefaultPriorityNonceMempoolConfig())
+	ph := baseapp.NewDefaultProposalHandler(mp, app)
+
+	invalidTx := []byte("invalid tx")
+	app.EXPECT().ProcessProposalVerifyTx(invalidTx).Return(nil, errors.New("decode error"))
+
+	logBuffer := new(bytes.Buffer)
+	ctx := s.ctx.WithLogger(log.NewLogger(logBuffer, log.ColorOption(false)))
+
+	// a rejection is not reported as an error, its reason is logged
+	resp, err := ph.ProcessProposalHandler()(ctx, &abci.RequestProcessProposal{Height: 1, Txs: [][]byte{invalidTx}})
+	s.Require().NoError(err)
+	s.Require().Equal(abci.ResponseProcessProposal_REJECT, resp.Status)
+	s.Require().Contains(logBuffer.String(), "rejected proposal")
+	s.Require().Contains(logBuffer.String(), "invalid tx: decode error")
+}
+
+func (s *ABCIUtilsTestSuite) TestValidateProposalTxsDecode() {
+	cdc := codectestutil.CodecOptions{}.NewCodec()
+	baseapptestutil.RegisterInterfaces(cdc.InterfaceRegistry())
+	signingCtx := cdc.InterfaceRegistry().SigningContext()
+
+	txConfig := authtx.NewTxConfig(cdc, signingCtx.AddressCodec(), signingCtx.ValidatorAddressCodec(), authtx.DefaultSignModes)
+	app := baseapp.NewBaseApp(s.T().Name(), log.NewNopLogger(), dbm.NewMemDB(), txConfig.TxDecoder())
+
+	_, _, addr := testdata.KeyTestPubAddr()
+	builder := txConfig.NewTxBuilder()
+	s.Require().NoError(builder.SetMsgs(
+		&baseapptestutil.MsgCounter{Counter: 0, FailOnHandler: false, Signer: addr.String()},
+	))
+	builder.SetGasLimit(100)
+	setTxSignature(s.T(), builder, 0)
+
+	txBz, err := txConfig.TxEncoder()(builder.GetTx())
+	s.Require().NoError(err)
+
+	veTxBz := []byte("ve:injected vote extensions")
+	isVoteExtension := baseapp.VoteExtensionTxPrefix([]byte("ve:"))
+
+	testCases := map[string]struct {
+		txs             [][]byte
+		isVoteExtension baseapp.VoteExtensionTxMatcher
+		expErr          string
+	}{
+		"clean proposal": {
+			txs:             [][]byte{txBz, txBz},
+			isVoteExtension: isVoteExtension,
+		},
+		"empty proposal": {
+			isVoteExtension: isVoteExtension,
+		},
+		"injected vote extension": {
+			txs:             [][]byte{veTxBz, txBz, txBz},
+			isVoteExtension: isVoteExtension,
+		},
+		"injected vote extension without matcher": {
+			txs:    [][]byte{veTxBz, txBz, txBz},
+			expErr: "failed to decode proposal tx 0",
+		},
+		"malformed tx": {
+			txs:             [][]byte{veTxBz, txBz, []byte("malformed")},
+			isVoteExtension: isVoteExtension,
+			expErr:          "failed to decode proposal tx 2",
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			err := baseapp.ValidateProposalTxsDecode(app, tc.txs, tc.isVoteExtension)
+			if tc.expErr == "" {
+				s.Require().NoError(err)
+			} else {
+				s.Require().ErrorContains(err, tc.expErr)
+			}
+		})
+	}
+}
+
 func marshalDelimitedFn(msg proto.Message) ([]byte, error) {
 	var buf bytes.Buffer
 	if err := protoio.NewDelimitedWriter(&buf).WriteMsg(msg); err != nil {
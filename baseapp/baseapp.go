@@ -1,19 +1,25 @@
 package baseapp
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"math"
 	"sort"
 	"strconv"
 	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/cockroachdb/errors"
 	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/crypto"
 	"github.com/cometbft/cometbft/crypto/tmhash"
 	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	cmttypes "github.com/cometbft/cometbft/types"
 	dbm "github.com/cosmos/cosmos-db"
 	"github.com/cosmos/gogoproto/proto"
+	lru "github.com/hashicorp/golang-lru"
 	"golang.org/x/exp/maps"
 	protov2 "google.golang.org/protobuf/proto"
 
@@ -22,6 +28,7 @@ import (
 	"cosmossdk.io/log"
 	"cosmossdk.io/store"
 	storemetrics "cosmossdk.io/store/metrics"
+	"cosmossdk.io/store/rootmulti"
 	"cosmossdk.io/store/snapshots"
 	storetypes "cosmossdk.io/store/types"
 
@@ -57,6 +64,15 @@ const (
 	execModeFinalize                            // Finalize a block proposal
 )
 
+// queryBlockTimeCacheSize is the number of historical block times cached for
+// query contexts.
+const queryBlockTimeCacheSize = 1024
+
+// initialHeightKey is the database key of the initial height persisted by
+// InitChain. It doesn't collide with the keys of the root multi-store, which
+// are prefixed with "s/".
+var initialHeightKey = []byte("baseapp/initial_height")
+
 var _ servertypes.ABCI = (*BaseApp)(nil)
 
 // BaseApp reflects the ABCI application implementation.
@@ -79,6 +95,10 @@ type BaseApp struct {
 	anteHandler sdk.AnteHandler // ante handler for fee and auth
 	postHandler sdk.PostHandler // post handler, optional
 
+	// deterministicSimulateAnteHandler, if set, replaces anteHandler in
+	// deterministic simulations, see SetDeterministicSimulateAnteHandler.
+	deterministicSimulateAnteHandler sdk.AnteHandler
+
 	initChainer        sdk.InitChainer                // ABCI InitChain handler
 	preBlocker         sdk.PreBlocker                 // logic to run before BeginBlocker
 	beginBlocker       sdk.BeginBlocker               // (legacy ABCI) BeginBlock handler
@@ -90,14 +110,38 @@ type BaseApp struct {
 	prepareCheckStater sdk.PrepareCheckStater         // logic to run during commit using the checkState
 	precommiter        sdk.Precommiter                // logic to run during commit using the deliverState
 
-	addrPeerFilter sdk.PeerFilter // filter peers by address and port
-	idPeerFilter   sdk.PeerFilter // filter peers by node ID
-	fauxMerkleMode bool           // if true, IAVL MountStores uses MountStoresDB for simulation speed.
-	sigverifyTx    bool           // in the simulation test, since the account does not have a private key, we have to ignore the tx sigverify.
+	addrPeerFilter sdk.PeerFilter   // filter peers by address and port
+	idPeerFilters  []sdk.PeerFilter // ordered chain of filters of peers by node ID
+	fauxMerkleMode bool             // if true, IAVL MountStores uses MountStoresDB for simulation speed.
+	sigverifyTx    bool             // in the simulation test, since the account does not have a private key, we have to ignore the tx sigverify.
+
+	// validateProposerAddress, if set, makes ProcessProposal reject proposals
+	// whose proposer address is not a well-formed consensus address.
+	validateProposerAddress bool
+
+	// rejectOversizedProposals, if set, makes PrepareProposal fail when the txs
+	// returned by the handler exceed the max tx bytes instead of trimming them.
+	rejectOversizedProposals bool
+
+	// maxProposalTimeSkew, if set, bounds how far ahead of the local clock the
+	// block time seen by the PrepareProposal handler can be.
+	maxProposalTimeSkew time.Duration
 
 	// manages snapshots, i.e. dumps of app state at certain intervals
 	snapshotManager *snapshots.Manager
 
+	// snapshotsInProgress counts the snapshots started by Commit that have not
+	// completed yet.
+	snapshotsInProgress atomic.Int32
+
+	// snapshotChunkMaxRejects defines how many times a single snapshot chunk may
+	// fail checksum verification before state sync is aborted; unbounded if 0.
+	snapshotChunkMaxRejects uint32
+
+	// snapshotChunkRejects tracks checksum mismatches per chunk index for the
+	// snapshot restoration in progress.
+	snapshotChunkRejects map[uint32]uint32
+
 	// volatile states:
 	//
 	// - checkState is set on InitChain and reset on Commit
@@ -117,7 +161,10 @@ type BaseApp struct {
 	//
 	// - finalizeBlockState: Used for FinalizeBlock, which is set based on the
 	// previous block's state. This state is committed.
-	checkState           *state
+	//
+	// checkState is published atomically as it is also read by queries and
+	// LatestCheckStateInfo, concurrently with block execution.
+	checkState           atomic.Pointer[state]
 	prepareProposalState *state
 	processProposalState *state
 	finalizeBlockState   *state
@@ -133,6 +180,31 @@ type BaseApp struct {
 	// queryGasLimit defines the maximum gas for queries; unbounded if 0.
 	queryGasLimit uint64
 
+	// queryBlockTimes caches the block times of historical heights read from
+	// their commit info by CreateQueryContext.
+	queryBlockTimes *lru.Cache
+
+	// queryPruningWarningWindow defines how many blocks ahead of the pruning
+	// cutoff a queried height gets a warning in the response log; disabled if 0.
+	queryPruningWarningWindow uint64
+
+	// appHashEvent, if set, makes FinalizeBlock emit an EventTypeAppHash event
+	// with the working app hash of the block.
+	appHashEvent bool
+
+	// maxQueryLag is the maximum number of blocks a queried height can be
+	// behind the last block height; unlimited if 0.
+	maxQueryLag uint64
+
+	// queryMaxResponseBytes caps the size of the value of store range queries,
+	// larger results are truncated; unlimited if 0.
+	queryMaxResponseBytes uint64
+
+	// healthMaxBlockAge is the maximum age of the last committed block for the
+	// app to be reported as active by the "/app/health" query; if 0,
+	// DefaultHealthMaxBlockAge is used.
+	healthMaxBlockAge time.Duration
+
 	// The minimum gas prices a validator is willing to accept for processing a
 	// transaction. This is mainly used for DoS and spam prevention.
 	minGasPrices sdk.DecCoins
@@ -140,6 +212,15 @@ type BaseApp struct {
 	// initialHeight is the initial height at which we start the BaseApp
 	initialHeight int64
 
+	// allowGenesisValidatorsMismatch, if set, logs a mismatch between the
+	// validators in InitChain and the ones returned by the InitChainer instead
+	// of failing.
+	allowGenesisValidatorsMismatch bool
+
+	// initChainDefaultConsensusParams, if set, makes InitChain store
+	// DefaultConsensusParams when the request carries no consensus params.
+	initChainDefaultConsensusParams bool
+
 	// flag for sealing options and parameters to a BaseApp
 	sealed bool
 
@@ -149,6 +230,27 @@ type BaseApp struct {
 	// minimum block time (in Unix seconds) at which to halt the chain and gracefully shutdown
 	haltTime uint64
 
+	// haltGracePeriod is how long the node keeps running, once the halt
+	// conditions are met, before halting
+	haltGracePeriod time.Duration
+
+	// clock is the source of wall-clock time, see SetClock.
+	clock Clock
+
+	// coalesceBeginBlockEvents, if set, drops begin block events identical to
+	// an event of the same type emitted by the previous committed block.
+	coalesceBeginBlockEvents bool
+
+	// lastBeginBlockEvents holds, per event type, the begin block events of the
+	// last committed block, and pendingBeginBlockEvents those of the block being
+	// finalized. They are only tracked when coalesceBeginBlockEvents is set.
+	lastBeginBlockEvents    map[string][]abci.Event
+	pendingBeginBlockEvents map[string][]abci.Event
+
+	// logHaltEvaluation, if set, logs every evaluation of the halt conditions
+	// at debug level, whether or not the chain halts.
+	logHaltEvaluation bool
+
 	// minRetainBlocks defines the minimum block height offset from the current
 	// block being committed, such that all blocks past this offset are pruned
 	// from CometBFT. It is used as part of the process of determining the
@@ -161,22 +263,106 @@ type BaseApp struct {
 	// ResponseCommit.RetainHeight.
 	minRetainBlocks uint64
 
+	// retainAllBlocksWithoutEvidenceParams, if set, makes the block retention
+	// height 0, i.e. no blocks are pruned, while the consensus params have no
+	// evidence params.
+	retainAllBlocksWithoutEvidenceParams bool
+
+	// missingEvidenceParamsWarned is set once the lack of evidence params has
+	// been logged by blockRetentionInfo, and reset when they are set.
+	missingEvidenceParamsWarned atomic.Bool
+
+	// evidenceAgeBlockTime, if set, is the average block time used to check that
+	// the evidence max age duration and number of blocks of stored consensus
+	// params are consistent. An inconsistency is logged, or rejected if
+	// strictEvidenceAgeConsistency is set.
+	evidenceAgeBlockTime         time.Duration
+	strictEvidenceAgeConsistency bool
+
 	// application's version string
 	version string
 
+	// commitIDQuery enables the "/app/commit-id" query returning the last
+	// commit ID of the multistore.
+	commitIDQuery bool
+
+	// queryableStores, if not empty, restricts "/store" queries to the stores
+	// with the given names.
+	queryableStores map[string]struct{}
+
+	// checkStateQueries enables querying the uncommitted check state by passing
+	// CheckStateQueryHeight as query height.
+	checkStateQueries bool
+
+	// effectiveLimits holds the limits declared by modules on top of the
+	// consensus params, served by the "/app/effective-limits" query.
+	effectiveLimits []EffectiveLimit
+
+	// validatorUpdatesDiffLimit is the maximum size of the validator set tracked
+	// to compute per block validator updates diffs; tracking is disabled if 0.
+	validatorUpdatesDiffLimit int
+
+	// validatorSet maps the tracked validators' public keys to their voting
+	// power, and validatorSetOverflow is set once the set grew past
+	// validatorUpdatesDiffLimit.
+	validatorSet         map[string]int64
+	validatorSetOverflow bool
+
+	// lastValidatorUpdatesDiff holds the validator updates diff of the last
+	// finalized block, served by the "/app/validator-updates-diff" query.
+	lastValidatorUpdatesDiff atomic.Pointer[ValidatorUpdatesDiff]
+
+	// blockProfiling enables recording the duration of each phase of finalized
+	// blocks. pendingBlockProfile holds the profile of the block being
+	// finalized until its working hash is computed.
+	blockProfiling      bool
+	pendingBlockProfile *BlockProfile
+
+	// lastBlockProfile holds the profile of the last finalized block, served by
+	// the "/app/block-profile" query.
+	lastBlockProfile atomic.Pointer[BlockProfile]
+
+	// blockGasInfo holds the block gas consumption of the block being finalized
+	// or of the last finalized block, served by the "/app/block-gas" query.
+	blockGasInfo atomic.Pointer[BlockGasInfo]
+
 	// recovery handler for app.runTx method
 	runTxRecoveryMiddleware recoveryMiddleware
 
 	// trace set will return full stack traces for errors in ABCI Log field
 	trace bool
 
+	// queryPanicRethrow, if set, re-panics on Query panics after logging the
+	// stack trace instead of converting them into ErrPanic query results.
+	queryPanicRethrow bool
+
+	// queryErrorInfo, if set, describes the error of failed queries as a JSON
+	// encoded QueryInfo in the response Info.
+	queryErrorInfo bool
+
 	// indexEvents defines the set of events in the form {eventType}.{attributeKey},
 	// which informs CometBFT what to index. If empty, all events will be indexed.
 	indexEvents map[string]struct{}
 
+	// deliverTxEventTransformer, if set, transforms the events of executed
+	// transactions before they are marked for indexing.
+	deliverTxEventTransformer func([]abci.Event) []abci.Event
+
 	// streamingManager for managing instances and configuration of ABCIListener services
 	streamingManager storetypes.StreamingManager
 
+	// listenerErrorsLimit bounds the number of ABCI listener errors collected
+	// for DrainListenerErrors; errors are not collected if 0.
+	listenerErrorsLimit int
+
+	// panicOnListenerError makes failing ABCI listener hooks panic instead of
+	// being logged, see SetPanicOnListenerError.
+	panicOnListenerError bool
+
+	// listenerErrors holds the collected ABCI listener errors, oldest first.
+	listenerErrorsMtx sync.Mutex
+	listenerErrors    []error
+
 	chainID string
 
 	cdc codec.Codec
@@ -185,6 +371,33 @@ type BaseApp struct {
 	// including the goroutine handling.This is experimental and must be enabled
 	// by developers.
 	optimisticExec *oe.OptimisticExecution
+
+	// optimisticExecFilter, if set, is consulted with the proposer address of
+	// an accepted proposal and disables optimistic execution for that block
+	// when it returns false.
+	optimisticExecFilter func(proposer []byte) bool
+
+	// strictFinalizeBlockDecoding, if set, makes FinalizeBlock fail on the
+	// first transaction that cannot be decoded instead of skipping it.
+	strictFinalizeBlockDecoding bool
+
+	// maxFinalizeBlockDuration, if positive, bounds the duration of blocks
+	// executed synchronously by FinalizeBlock.
+	maxFinalizeBlockDuration time.Duration
+
+	// appHashMixer, if set, derives the app hash returned to CometBFT from the
+	// working hash of the multistore.
+	appHashMixer func(ctx sdk.Context, storeHash []byte) []byte
+
+	// mixedAppHash is the last app hash derived with appHashMixer.
+	mixedAppHash mixedAppHash
+}
+
+// mixedAppHash is an app hash derived with the app hash mixer and the height
+// of the block it was derived for.
+type mixedAppHash struct {
+	height int64
+	hash   []byte
 }
 
 // NewBaseApp returns a reference to an initialized BaseApp. It accepts a
@@ -205,8 +418,15 @@ func NewBaseApp(
 		fauxMerkleMode:   false,
 		sigverifyTx:      true,
 		queryGasLimit:    math.MaxUint64,
+		clock:            realClock{},
 	}
 
+	queryBlockTimes, err := lru.New(queryBlockTimeCacheSize)
+	if err != nil {
+		panic(err)
+	}
+	app.queryBlockTimes = queryBlockTimes
+
 	for _, option := range options {
 		option(app)
 	}
@@ -395,6 +615,57 @@ func (app *BaseApp) LastCommitID() storetypes.CommitID {
 	return app.cms.LastCommitID()
 }
 
+// LastCommitInfo returns the commit info of the last committed height, i.e.
+// the version and hash of each store of the multistore. It errors if the
+// multistore is not a rootmulti.Store or if nothing has been committed yet.
+func (app *BaseApp) LastCommitInfo() (*storetypes.CommitInfo, error) {
+	rms, ok := app.cms.(*rootmulti.Store)
+	if !ok {
+		return nil, fmt.Errorf("multistore of type %T does not expose commit info", app.cms)
+	}
+
+	return rms.GetCommitInfo(rms.LastCommitID().Version)
+}
+
+// InitialHeight returns the height of the first block of the chain, as set by
+// InitChain. It is restored from the database on restart and is zero if
+// InitChain was never called.
+func (app *BaseApp) InitialHeight() int64 {
+	return app.initialHeight
+}
+
+// storeInitialHeight persists the initial height so that it can be restored
+// by Init on restart.
+func (app *BaseApp) storeInitialHeight() error {
+	if app.db == nil {
+		return nil
+	}
+
+	if err := app.db.Set(initialHeightKey, sdk.Uint64ToBigEndian(uint64(app.initialHeight))); err != nil {
+		return fmt.Errorf("failed to store initial height: %w", err)
+	}
+
+	return nil
+}
+
+// loadInitialHeight restores the initial height persisted by InitChain, if any.
+func (app *BaseApp) loadInitialHeight() error {
+	if app.db == nil {
+		return nil
+	}
+
+	bz, err := app.db.Get(initialHeightKey)
+	if err != nil {
+		return fmt.Errorf("failed to load initial height: %w", err)
+	}
+
+	if bz != nil {
+		app.initialHeight = int64(sdk.BigEndianToUint64(bz))
+	}
+
+	return nil
+}
+
 // LastBlockHeight returns the last committed block height.
 func (app *BaseApp) LastBlockHeight() int64 {
 	return app.cms.LastCommitID().Version
@@ -428,6 +699,10 @@ func (app *BaseApp) Init() error {
 		return errors.New("commit multi-store must not be nil")
 	}
 
+	if err := app.loadInitialHeight(); err != nil {
+		return err
+	}
+
 	emptyHeader := cmtproto.Header{ChainID: app.chainID}
 
 	// needed for the export command which inits from store but never calls initchain
@@ -477,8 +752,10 @@ func (app *BaseApp) IsSealed() bool { return app.sealed }
 
 // setState sets the BaseApp's state for the corresponding mode with a branched
 // multi-store (i.e. a CacheMultiStore) and a new Context with the same
-// multi-store branch, and provided header.
+// multi-store branch, and provided header. The header is copied, so that the
+// state is not affected by later changes to the byte slices of h.
 func (app *BaseApp) setState(mode execMode, h cmtproto.Header) {
+	h = cloneHeader(h)
 	ms := app.cms.CacheMultiStore()
 	headerInfo := header.Info{
 		Height:  h.Height,
@@ -497,7 +774,8 @@ func (app *BaseApp) setState(mode execMode, h cmtproto.Header) {
 	switch mode {
 	case execModeCheck:
 		baseState.SetContext(baseState.Context().WithIsCheckTx(true).WithMinGasPrices(app.minGasPrices))
-		app.checkState = baseState
+
+		app.checkState.Store(baseState)
 
 	case execModePrepareProposal:
 		app.prepareProposalState = baseState
@@ -513,6 +791,37 @@ func (app *BaseApp) setState(mode execMode, h cmtproto.Header) {
 	}
 }
 
+// LatestCheckStateInfo returns the block height, time and chain ID of the
+// check state, i.e. of the last committed block. It is safe to call from other
+// goroutines, e.g. to collect metrics, while blocks are executed. It returns
+// false if the check state is not initialized yet.
+func (app *BaseApp) LatestCheckStateInfo() (StateInfo, bool) {
+	checkState := app.checkState.Load()
+	if checkState == nil {
+		return StateInfo{}, false
+	}
+
+	return checkState.Snapshot(), true
+}
+
+// cloneHeader returns a deep copy of the given header, whose byte slices are
+// otherwise shared with the ABCI requests and the other states.
+func cloneHeader(h cmtproto.Header) cmtproto.Header {
+	h.LastBlockId.Hash = bytes.Clone(h.LastBlockId.Hash)
+	h.LastBlockId.PartSetHeader.Hash = bytes.Clone(h.LastBlockId.PartSetHeader.Hash)
+	h.LastCommitHash = bytes.Clone(h.LastCommitHash)
+	h.DataHash = bytes.Clone(h.DataHash)
+	h.ValidatorsHash = bytes.Clone(h.ValidatorsHash)
+	h.NextValidatorsHash = bytes.Clone(h.NextValidatorsHash)
+	h.ConsensusHash = bytes.Clone(h.ConsensusHash)
+	h.AppHash = bytes.Clone(h.AppHash)
+	h.LastResultsHash = bytes.Clone(h.LastResultsHash)
+	h.EvidenceHash = bytes.Clone(h.EvidenceHash)
+	h.ProposerAddress = bytes.Clone(h.ProposerAddress)
+
+	return h
+}
+
 // SetCircuitBreaker sets the circuit breaker for the BaseApp.
 // The circuit breaker is checked on every message execution to verify if a transaction should be executed or not.
 func (app *BaseApp) SetCircuitBreaker(cb CircuitBreaker) {
@@ -548,9 +857,48 @@ func (app *BaseApp) StoreConsensusParams(ctx sdk.Context, cp cmtproto.ConsensusP
 		return errors.New("cannot store consensus params with no params store set")
 	}
 
+	if err := app.checkEvidenceAgeConsistency(cp.Evidence); err != nil {
+		return err
+	}
+
 	return app.paramStore.Set(ctx, cp)
 }
 
+// maxEvidenceAgeRatio is the maximum ratio between the evidence max age
+// duration and the duration of MaxAgeNumBlocks blocks, in either direction,
+// for the evidence params to be considered consistent.
+const maxEvidenceAgeRatio = 2
+
+// checkEvidenceAgeConsistency checks that the evidence max age duration is
+// within a factor maxEvidenceAgeRatio of the time MaxAgeNumBlocks blocks take
+// at the configured average block time. Inconsistencies are logged, or
+// returned as an error in strict mode. The check is skipped if no average
+// block time is configured or either max age is unset.
+func (app *BaseApp) checkEvidenceAgeConsistency(evidence *cmtproto.EvidenceParams) error {
+	if app.evidenceAgeBlockTime <= 0 || evidence == nil || evidence.MaxAgeNumBlocks <= 0 || evidence.MaxAgeDuration <= 0 {
+		return nil
+	}
+
+	// compute the ratio in floating point as the duration of MaxAgeNumBlocks
+	// blocks may overflow a time.Duration
+	blocksAge := float64(evidence.MaxAgeNumBlocks) * float64(app.evidenceAgeBlockTime)
+	ratio := blocksAge / float64(evidence.MaxAgeDuration)
+	if ratio <= maxEvidenceAgeRatio && ratio >= 1.0/maxEvidenceAgeRatio {
+		return nil
+	}
+
+	err := fmt.Errorf(
+		"evidence max age of %d blocks, i.e. about %s at an average block time of %s, is inconsistent with the evidence max age duration of %s",
+		evidence.MaxAgeNumBlocks, time.Duration(min(blocksAge, math.MaxInt64)), app.evidenceAgeBlockTime, evidence.MaxAgeDuration,
+	)
+	if app.strictEvidenceAgeConsistency {
+		return err
+	}
+
+	app.logger.Warn("inconsistent evidence consensus params", "err", err)
+	return nil
+}
+
 // AddRunTxRecoveryHandler adds custom app.runTx method panic handlers.
 func (app *BaseApp) AddRunTxRecoveryHandler(handlers ...RecoveryHandler) {
 	for _, h := range handlers {
@@ -610,6 +958,79 @@ func (app *BaseApp) validateFinalizeBlockHeight(req *abci.RequestFinalizeBlock)
 	return nil
 }
 
+// minBlockTxSize is the minimum number of bytes a transaction takes in the
+// protobuf encoding of a block's data, i.e. its field tag and length prefix.
+const minBlockTxSize = 2
+
+// validateFinalizeBlockTxCount rejects blocks carrying more transactions than
+// could fit in the block size allowed by the consensus params, which CometBFT
+// should never produce. This bounds the memory allocated for the transaction
+// results before any transaction is executed.
+func (app *BaseApp) validateFinalizeBlockTxCount(ctx sdk.Context, req *abci.RequestFinalizeBlock) error {
+	maxBytes := int64(cmttypes.MaxBlockSizeBytes)
+	if block := ctx.ConsensusParams().Block; block != nil && block.MaxBytes > 0 {
+		maxBytes = block.MaxBytes
+	}
+
+	if maxTxs := maxBytes / minBlockTxSize; int64(len(req.Txs)) > maxTxs {
+		return errorsmod.Wrapf(
+			sdkerrors.ErrInvalidRequest,
+			"block %d contains %d txs, more than the %d txs fitting in %d bytes", req.Height, len(req.Txs), maxTxs, maxBytes,
+		)
+	}
+
+	return nil
+}
+
+// ValidateFinalizeBlockRequest performs the structural validation of a
+// RequestFinalizeBlock without executing it. It checks the height against the
+// last committed height, the decided last commit votes, the misbehavior
+// evidence, the proposer address and the transactions. Height errors wrap
+// ErrInvalidHeight and all other errors wrap ErrInvalidRequest.
+func (app *BaseApp) ValidateFinalizeBlockRequest(req *abci.RequestFinalizeBlock) error {
+	if req == nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "nil finalize block request")
+	}
+
+	if err := app.validateFinalizeBlockHeight(req); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidHeight, err.Error())
+	}
+
+	if len(req.ProposerAddress) > 0 && len(req.ProposerAddress) != crypto.AddressSize {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid proposer address length: %d", len(req.ProposerAddress))
+	}
+
+	if req.DecidedLastCommit.Round < 0 {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "negative decided last commit round: %d", req.DecidedLastCommit.Round)
+	}
+
+	for i, vote := range req.DecidedLastCommit.Votes {
+		if len(vote.Validator.Address) != crypto.AddressSize {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid validator address length in decided last commit vote %d: %d", i, len(vote.Validator.Address))
+		}
+		if vote.Validator.Power < 0 {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "negative validator power in decided last commit vote %d: %d", i, vote.Validator.Power)
+		}
+	}
+
+	for i, misbehavior := range req.Misbehavior {
+		if len(misbehavior.Validator.Address) != crypto.AddressSize {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid validator address length in misbehavior %d: %d", i, len(misbehavior.Validator.Address))
+		}
+		if misbehavior.Height < 1 || misbehavior.Height >= req.Height {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid misbehavior %d height: %d", i, misbehavior.Height)
+		}
+	}
+
+	for i, tx := range req.Txs {
+		if len(tx) == 0 {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "empty tx at index %d", i)
+		}
+	}
+
+	return nil
+}
+
 // validateBasicTxMsgs executes basic validator calls for messages firstly by invoking
 // .ValidateBasic if possible, then checking if the message has a known handler.
 func validateBasicTxMsgs(router *MsgServiceRouter, msgs []sdk.Msg) error {
@@ -644,7 +1065,7 @@ func (app *BaseApp) getState(mode execMode) *state {
 		return app.processProposalState
 
 	default:
-		return app.checkState
+		return app.checkState.Load()
 	}
 }
 
@@ -730,11 +1151,17 @@ func (app *BaseApp) beginBlock(req *abci.RequestFinalizeBlock) (sdk.BeginBlock,
 	)
 
 	if app.beginBlocker != nil {
-		resp, err = app.beginBlocker(app.finalizeBlockState.Context())
+		ctx := app.finalizeBlockState.Context()
+		gasBefore := ctx.GasMeter().GasConsumed()
+
+		resp, err = app.beginBlocker(ctx)
 		if err != nil {
 			return resp, err
 		}
 
+		// report the gas consumed by module hooks, which is not part of any tx
+		telemetry.SetGauge(float32(ctx.GasMeter().GasConsumed()-gasBefore), telemetry.MetricKeyBeginBlocker, "gas", "used")
+
 		// append BeginBlock attributes to all events in the BeginBlock response
 		for i, event := range resp.Events {
 			resp.Events[i].Attributes = append(
@@ -744,11 +1171,42 @@ func (app *BaseApp) beginBlock(req *abci.RequestFinalizeBlock) (sdk.BeginBlock,
 		}
 
 		resp.Events = sdk.MarkEventsToIndex(resp.Events, app.indexEvents)
+
+		if app.coalesceBeginBlockEvents {
+			resp.Events = app.coalesceEvents(resp.Events)
+		}
 	}
 
 	return resp, nil
 }
 
+// coalesceEvents drops the begin block events that are identical to an event of
+// the same type emitted by the last committed block. All given events, including
+// the dropped ones, are recorded as the reference for the next block once the
+// current block is committed.
+func (app *BaseApp) coalesceEvents(events []abci.Event) []abci.Event {
+	app.pendingBeginBlockEvents = make(map[string][]abci.Event, len(events))
+
+	coalesced := make([]abci.Event, 0, len(events))
+	for _, event := range events {
+		app.pendingBeginBlockEvents[event.Type] = append(app.pendingBeginBlockEvents[event.Type], event)
+
+		duplicate := false
+		for _, last := range app.lastBeginBlockEvents[event.Type] {
+			if proto.Equal(&last, &event) {
+				duplicate = true
+				break
+			}
+		}
+
+		if !duplicate {
+			coalesced = append(coalesced, event)
+		}
+	}
+
+	return coalesced
+}
+
 func (app *BaseApp) deliverTx(tx []byte) *abci.ExecTxResult {
 	gInfo := sdk.GasInfo{}
 	resultStr := "successful"
@@ -769,7 +1227,7 @@ func (app *BaseApp) deliverTx(tx []byte) *abci.ExecTxResult {
 			err,
 			gInfo.GasWanted,
 			gInfo.GasUsed,
-			sdk.MarkEventsToIndex(anteEvents, app.indexEvents),
+			app.markDeliverTxEventsToIndex(anteEvents),
 			app.trace,
 		)
 		return resp
@@ -780,23 +1238,38 @@ func (app *BaseApp) deliverTx(tx []byte) *abci.ExecTxResult {
 		GasUsed:   int64(gInfo.GasUsed),
 		Log:       result.Log,
 		Data:      result.Data,
-		Events:    sdk.MarkEventsToIndex(result.Events, app.indexEvents),
+		Events:    app.markDeliverTxEventsToIndex(result.Events),
 	}
 
 	return resp
 }
 
+// markDeliverTxEventsToIndex applies the deliver tx event transformer, if set,
+// to the given transaction events and marks the result for indexing.
+func (app *BaseApp) markDeliverTxEventsToIndex(events []abci.Event) []abci.Event {
+	if app.deliverTxEventTransformer != nil {
+		events = app.deliverTxEventTransformer(events)
+	}
+
+	return sdk.MarkEventsToIndex(events, app.indexEvents)
+}
+
 // endBlock is an application-defined function that is called after transactions
 // have been processed in FinalizeBlock.
 func (app *BaseApp) endBlock(ctx context.Context) (sdk.EndBlock, error) {
 	var endblock sdk.EndBlock
 
 	if app.endBlocker != nil {
-		eb, err := app.endBlocker(app.finalizeBlockState.Context())
+		sdkCtx := app.finalizeBlockState.Context()
+		gasBefore := sdkCtx.GasMeter().GasConsumed()
+
+		eb, err := app.endBlocker(sdkCtx)
 		if err != nil {
 			return endblock, err
 		}
 
+		telemetry.SetGauge(float32(sdkCtx.GasMeter().GasConsumed()-gasBefore), telemetry.MetricKeyEndBlocker, "gas", "used")
+
 		// append EndBlock attributes to all events in the EndBlock response
 		for i, event := range eb.Events {
 			eb.Events[i].Attributes = append(
@@ -820,12 +1293,19 @@ func (app *BaseApp) endBlock(ctx context.Context) (sdk.EndBlock, error) {
 // returned if the tx does not run out of gas and if all the messages are valid
 // and execute successfully. An error is returned otherwise.
 func (app *BaseApp) runTx(mode execMode, txBytes []byte) (gInfo sdk.GasInfo, result *sdk.Result, anteEvents []abci.Event, err error) {
+	return app.runTxWithContext(app.getContextForTx(mode, txBytes), mode, txBytes)
+}
+
+// runTxWithContext processes a transaction like runTx, on top of the given
+// Context. In simulation mode, the state changes of successful messages are
+// written to the given Context's (already branched) multi-store, so that
+// transactions simulated on the same Context build on each other.
+func (app *BaseApp) runTxWithContext(ctx sdk.Context, mode execMode, txBytes []byte) (gInfo sdk.GasInfo, result *sdk.Result, anteEvents []abci.Event, err error) {
 	// NOTE: GasWanted should be returned by the AnteHandler. GasUsed is
 	// determined by the GasMeter. We need access to the context to get the gas
 	// meter, so we initialize upfront.
 	var gasWanted uint64
 
-	ctx := app.getContextForTx(mode, txBytes)
 	ms := ctx.MultiStore()
 
 	// only run the tx if there is block gas remaining
@@ -877,7 +1357,7 @@ func (app *BaseApp) runTx(mode execMode, txBytes []byte) (gInfo sdk.GasInfo, res
 		return sdk.GasInfo{}, nil, nil, err
 	}
 
-	if app.anteHandler != nil {
+	if anteHandler := app.txAnteHandler(ctx); anteHandler != nil {
 		var (
 			anteCtx sdk.Context
 			msCache storetypes.CacheMultiStore
@@ -895,7 +1375,7 @@ func (app *BaseApp) runTx(mode execMode, txBytes []byte) (gInfo sdk.GasInfo, res
 		if mode == execModeSimulate {
 			anteCtx = anteCtx.WithExecMode(sdk.ExecMode(execModeSimulate))
 		}
-		newCtx, err := app.anteHandler(anteCtx, tx, mode == execModeSimulate)
+		newCtx, err := anteHandler(anteCtx, tx, mode == execModeSimulate)
 
 		if !newCtx.IsZero() {
 			// At this point, newCtx.MultiStore() is a store branch, or something else
@@ -972,6 +1452,10 @@ func (app *BaseApp) runTx(mode execMode, txBytes []byte) (gInfo sdk.GasInfo, res
 			// When block gas exceeds, it'll panic and won't commit the cached store.
 			consumeBlockGas()
 
+			msCache.Write()
+		} else if mode == execModeSimulate {
+			// simulations run on a branch of the check state that is never
+			// committed, see getContextForTx
 			msCache.Write()
 		}
 
@@ -4,8 +4,10 @@ import (
 	"bytes"
 	"context"
 	"crypto/sha256"
+	"encoding/json"
 	"fmt"
 	"math/rand"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -32,6 +34,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/testutil"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 var (
@@ -406,6 +409,224 @@ func TestVersionSetterGetter(t *testing.T) {
 	require.Equal(t, versionString, string(res.Value))
 }
 
+func TestVersionInfoQuery(t *testing.T) {
+	suite := NewBaseAppSuite(t, func(bapp *baseapp.BaseApp) { bapp.SetVersion("1.0.0") })
+
+	// before the first commit only the version fields are set
+	res, err := suite.baseApp.Query(context.TODO(), &abci.RequestQuery{Path: "app/version-info"})
+	require.NoError(t, err)
+	require.True(t, res.IsOK())
+	require.JSONEq(t, fmt.Sprintf(`{"name":%q,"version":"1.0.0","app_version":0}`, t.Name()), string(res.Value))
+
+	_, err = suite.baseApp.InitChain(&abci.RequestInitChain{
+		ConsensusParams: &cmtproto.ConsensusParams{Version: &cmtproto.VersionParams{App: 1}},
+	})
+	require.NoError(t, err)
+	_, err = suite.baseApp.FinalizeBlock(&abci.RequestFinalizeBlock{Height: 1})
+	require.NoError(t, err)
+	_, err = suite.baseApp.Commit()
+	require.NoError(t, err)
+
+	res, err = suite.baseApp.Query(context.TODO(), &abci.RequestQuery{Path: "app/version-info"})
+	require.NoError(t, err)
+	require.True(t, res.IsOK())
+
+	var info baseapp.VersionInfo
+	require.NoError(t, json.Unmarshal(res.Value, &info))
+	require.Equal(t, t.Name(), info.Name)
+	require.Equal(t, "1.0.0", info.Version)
+	require.Equal(t, uint64(1), info.AppVersion)
+	require.Equal(t, int64(1), info.LastBlockHeight)
+	require.Equal(t, suite.baseApp.LastCommitID().Hash, []byte(info.LastBlockAppHash))
+
+	// the plaintext path is unchanged
+	res, err = suite.baseApp.Query(context.TODO(), &abci.RequestQuery{Path: "app/version"})
+	require.NoError(t, err)
+	require.True(t, res.IsOK())
+	require.Equal(t, "1.0.0", string(res.Value))
+}
+
+func TestConsensusParamsQuery(t *testing.T) {
+	app := baseapp.NewBaseApp(
+		t.Name(), log.NewNopLogger(), dbm.NewMemDB(), nil,
+		baseapp.SetPruning(pruningtypes.NewCustomPruningOptions(2, 10)),
+	)
+	app.MountStores(capKey1)
+	app.SetParamStore(kvParamStore{key: capKey1})
+	app.SetEndBlocker(func(ctx sdk.Context) (sdk.EndBlock, error) {
+		// every block changes the max gas
+		cp := app.GetConsensusParams(ctx)
+		cp.Block.MaxGas = 1000 * ctx.BlockHeight()
+		return sdk.EndBlock{}, app.StoreConsensusParams(ctx, cp)
+	})
+	require.NoError(t, app.LoadLatestVersion())
+
+	_, err := app.InitChain(&abci.RequestInitChain{
+		ConsensusParams: &cmtproto.ConsensusParams{
+			Block: &cmtproto.BlockParams{MaxBytes: 200000, MaxGas: 1},
+		},
+	})
+	require.NoError(t, err)
+
+	lastHeight := int64(12)
+	for height := int64(1); height <= lastHeight; height++ {
+		_, err = app.FinalizeBlock(&abci.RequestFinalizeBlock{Height: height})
+		require.NoError(t, err)
+		_, err = app.Commit()
+		require.NoError(t, err)
+	}
+
+	queryMaxGas := func(height int64) (int64, *abci.ResponseQuery) {
+		res, err := app.Query(context.TODO(), &abci.RequestQuery{Path: "app/consensus-params", Height: height})
+		require.NoError(t, err)
+		if !res.IsOK() {
+			return 0, res
+		}
+
+		var cp cmtproto.ConsensusParams
+		require.NoError(t, json.Unmarshal(res.Value, &cp))
+		require.Equal(t, int64(200000), cp.Block.MaxBytes)
+		return cp.Block.MaxGas, res
+	}
+
+	// the latest params are returned when no height is given
+	maxGas, res := queryMaxGas(0)
+	require.Equal(t, lastHeight*1000, maxGas)
+	require.Equal(t, lastHeight, res.Height)
+
+	for height := lastHeight - 2; height <= lastHeight; height++ {
+		maxGas, res = queryMaxGas(height)
+		require.Equal(t, height*1000, maxGas)
+		require.Equal(t, height, res.Height)
+	}
+
+	// pruned and future heights are rejected
+	_, res = queryMaxGas(1)
+	require.Equal(t, sdkerrors.ErrInvalidRequest.ABCICode(), res.Code)
+	require.Contains(t, res.Log, "consensus params at height 1 are not available")
+
+	_, res = queryMaxGas(lastHeight + 1)
+	require.Equal(t, sdkerrors.ErrInvalidHeight.ABCICode(), res.Code)
+}
+
+func TestSetAppVersionDuringUpgrade(t *testing.T) {
+	const upgradeHeight = 2
+
+	app := baseapp.NewBaseApp(t.Name(), log.NewNopLogger(), dbm.NewMemDB(), nil)
+	app.MountStores(capKey1)
+	app.SetParamStore(kvParamStore{key: capKey1})
+	app.SetPreBlocker(func(ctx sdk.Context, _ *abci.RequestFinalizeBlock) (*sdk.ResponsePreBlock, error) {
+		// the upgrade handler bumps the app version
+		if ctx.BlockHeight() != upgradeHeight {
+			return &sdk.ResponsePreBlock{}, nil
+		}
+		return &sdk.ResponsePreBlock{ConsensusParamsChanged: true}, app.SetAppVersion(ctx, 2)
+	})
+	require.NoError(t, app.LoadLatestVersion())
+
+	_, err := app.InitChain(&abci.RequestInitChain{
+		ConsensusParams: &cmtproto.ConsensusParams{
+			Block:   &cmtproto.BlockParams{MaxBytes: 200000, MaxGas: 1},
+			Version: &cmtproto.VersionParams{App: 1},
+		},
+	})
+	require.NoError(t, err)
+
+	queryAppVersions := func() (info, params uint64) {
+		res, err := app.Info(&abci.RequestInfo{})
+		require.NoError(t, err)
+
+		qres, err := app.Query(context.TODO(), &abci.RequestQuery{Path: "app/consensus-params"})
+		require.NoError(t, err)
+		require.True(t, qres.IsOK(), qres.Log)
+
+		var cp cmtproto.ConsensusParams
+		require.NoError(t, json.Unmarshal(qres.Value, &cp))
+		return res.AppVersion, cp.Version.App
+	}
+
+	for height := int64(1); height <= upgradeHeight; height++ {
+		_, err = app.FinalizeBlock(&abci.RequestFinalizeBlock{Height: height})
+		require.NoError(t, err)
+		_, err = app.Commit()
+		require.NoError(t, err)
+
+		expVersion := uint64(1)
+		if height == upgradeHeight {
+			expVersion = 2
+		}
+		infoVersion, paramsVersion := queryAppVersions()
+		require.Equal(t, expVersion, infoVersion)
+		require.Equal(t, expVersion, paramsVersion)
+	}
+
+	// the app version cannot be set outside of block execution
+	err = app.SetAppVersion(app.NewContext(true), 3)
+	require.ErrorContains(t, err, "app version can only be set while executing a block")
+	err = app.SetAppVersion(app.NewUncachedContext(false, cmtproto.Header{}).WithExecMode(sdk.ExecModeProcessProposal), 3)
+	require.ErrorContains(t, err, "app version can only be set while executing a block")
+
+	infoVersion, paramsVersion := queryAppVersions()
+	require.Equal(t, uint64(2), infoVersion)
+	require.Equal(t, uint64(2), paramsVersion)
+}
+
+func TestEffectiveLimitsQuery(t *testing.T) {
+	msgSizeLimit := baseapp.EffectiveLimit{
+		Module:      "testmodule",
+		Name:        "max_msg_bytes",
+		Value:       1024,
+		Description: "maximum size of a testmodule message",
+	}
+	suite := NewBaseAppSuite(t, func(bapp *baseapp.BaseApp) { bapp.RegisterEffectiveLimits(msgSizeLimit) })
+
+	cp := cmtproto.ConsensusParams{
+		Block: &cmtproto.BlockParams{MaxBytes: 200000, MaxGas: 2000000},
+	}
+	_, err := suite.baseApp.InitChain(&abci.RequestInitChain{ConsensusParams: &cp})
+	require.NoError(t, err)
+
+	res, err := suite.baseApp.Query(context.TODO(), &abci.RequestQuery{Path: "app/effective-limits"})
+	require.NoError(t, err)
+	require.True(t, res.IsOK(), res.Log)
+
+	var limits baseapp.EffectiveLimits
+	require.NoError(t, json.Unmarshal(res.Value, &limits))
+	require.Equal(t, cp, limits.ConsensusParams)
+	require.Equal(t, []baseapp.EffectiveLimit{msgSizeLimit}, limits.ModuleLimits)
+
+	// a module limit can only be declared once
+	require.Panics(t, func() {
+		NewBaseAppSuite(t, func(bapp *baseapp.BaseApp) { bapp.RegisterEffectiveLimits(msgSizeLimit, msgSizeLimit) })
+	})
+}
+
+func TestCommitIDQuery(t *testing.T) {
+	suite := NewBaseAppSuite(t)
+	res, err := suite.baseApp.Query(context.TODO(), &abci.RequestQuery{Path: "app/commit-id"})
+	require.NoError(t, err)
+	require.False(t, res.IsOK())
+
+	suite = NewBaseAppSuite(t, baseapp.SetCommitIDQuery(true))
+	_, err = suite.baseApp.InitChain(&abci.RequestInitChain{
+		ConsensusParams: &cmtproto.ConsensusParams{},
+	})
+	require.NoError(t, err)
+	_, err = suite.baseApp.FinalizeBlock(&abci.RequestFinalizeBlock{Height: 1})
+	require.NoError(t, err)
+	_, err = suite.baseApp.Commit()
+	require.NoError(t, err)
+
+	res, err = suite.baseApp.Query(context.TODO(), &abci.RequestQuery{Path: "app/commit-id"})
+	require.NoError(t, err)
+	require.True(t, res.IsOK())
+
+	var commitID storetypes.CommitID
+	require.NoError(t, json.Unmarshal(res.Value, &commitID))
+	require.Equal(t, int64(1), commitID.Version)
+	require.Equal(t, suite.baseApp.LastCommitID(), commitID)
+}
+
 func TestLoadVersionInvalid(t *testing.T) {
 	logger := log.NewNopLogger()
 	pruningOpt := baseapp.SetPruning(pruningtypes.NewPruningOptions(pruningtypes.PruningNothing))
@@ -487,15 +708,36 @@ func TestBaseAppOptionSeal(t *testing.T) {
 	require.Panics(t, func() {
 		suite.baseApp.SetAnteHandler(nil)
 	})
+	require.Panics(t, func() {
+		suite.baseApp.SetDeterministicSimulateAnteHandler(nil)
+	})
+	require.Panics(t, func() {
+		suite.baseApp.SetDeliverTxEventTransformer(nil)
+	})
+	require.Panics(t, func() {
+		suite.baseApp.SetEvidenceAgeConsistencyCheck(0, false)
+	})
+	require.Panics(t, func() {
+		suite.baseApp.SetInitChainDefaultConsensusParams(false)
+	})
 	require.Panics(t, func() {
 		suite.baseApp.SetAddrPeerFilter(nil)
 	})
 	require.Panics(t, func() {
 		suite.baseApp.SetIDPeerFilter(nil)
 	})
+	require.Panics(t, func() {
+		suite.baseApp.SetIDPeerFilters()
+	})
 	require.Panics(t, func() {
 		suite.baseApp.SetFauxMerkleMode()
 	})
+	require.Panics(t, func() {
+		suite.baseApp.SetPanicOnListenerError(true)
+	})
+	require.Panics(t, func() {
+		suite.baseApp.SetQueryErrorInfo(true)
+	})
 }
 
 func TestTxDecoder(t *testing.T) {
@@ -732,6 +974,144 @@ func TestABCI_CreateQueryContext(t *testing.T) {
 	}
 }
 
+func TestABCI_CreateQueryContext_MaxQueryLag(t *testing.T) {
+	t.Parallel()
+
+	app := baseapp.NewBaseApp(t.Name(), log.NewTestLogger(t), dbm.NewMemDB(), nil, baseapp.SetMaxQueryLag(2))
+	app.MountStores(capKey1)
+	require.NoError(t, app.LoadLatestVersion())
+
+	for height := int64(1); height <= 5; height++ {
+		_, err := app.FinalizeBlock(&abci.RequestFinalizeBlock{Height: height})
+		require.NoError(t, err)
+		_, err = app.Commit()
+		require.NoError(t, err)
+	}
+
+	testCases := []struct {
+		name   string
+		height int64
+		expErr bool
+	}{
+		{"latest height", 0, false},
+		{"last height", 5, false},
+		{"oldest height in the window", 3, false},
+		{"height behind the window", 2, true},
+		{"first height", 1, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := app.CreateQueryContext(tc.height, false)
+			if tc.expErr {
+				require.ErrorIs(t, err, sdkerrors.ErrInvalidHeight)
+				require.ErrorContains(t, err, "queryable heights are 3 to 5")
+			} else {
+				require.NoError(t, err)
+			}
+
+			res, err := app.Query(context.TODO(), &abci.RequestQuery{Path: "/store/key1/key", Data: []byte("key"), Height: tc.height})
+			require.NoError(t, err)
+			if tc.expErr {
+				require.Equal(t, sdkerrors.ErrInvalidHeight.ABCICode(), res.Code)
+			} else {
+				require.True(t, res.IsOK(), res.Log)
+			}
+		})
+	}
+}
+
+func TestABCI_CreateQueryContext_CheckState(t *testing.T) {
+	counterKey := []byte("counter-key")
+	anteOpt := func(bapp *baseapp.BaseApp) { bapp.SetAnteHandler(anteHandlerTxTest(t, capKey1, counterKey)) }
+	suite := NewBaseAppSuite(t, anteOpt, baseapp.SetCheckStateQueries(true))
+	baseapptestutil.RegisterCounterServer(suite.baseApp.MsgServiceRouter(), CounterServerImplGasMeterOnly{})
+
+	_, err := suite.baseApp.InitChain(&abci.RequestInitChain{
+		ConsensusParams: &cmtproto.ConsensusParams{},
+	})
+	require.NoError(t, err)
+	_, err = suite.baseApp.FinalizeBlock(&abci.RequestFinalizeBlock{Height: 1})
+	require.NoError(t, err)
+	_, err = suite.baseApp.Commit()
+	require.NoError(t, err)
+
+	txBytes, err := suite.txConfig.TxEncoder()(newTxCounter(t, suite.txConfig, 0, 0))
+	require.NoError(t, err)
+	res, err := suite.baseApp.CheckTx(&abci.RequestCheckTx{Tx: txBytes, Type: abci.CheckTxType_New})
+	require.NoError(t, err)
+	require.True(t, res.IsOK(), res.Log)
+
+	// the counter written by CheckTx is only visible in the check state
+	ctx, err := suite.baseApp.CreateQueryContext(0, false)
+	require.NoError(t, err)
+	require.Equal(t, int64(0), getIntFromStore(t, ctx.KVStore(capKey1), counterKey))
+
+	ctx, err = suite.baseApp.CreateQueryContext(baseapp.CheckStateQueryHeight, false)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), getIntFromStore(t, ctx.KVStore(capKey1), counterKey))
+	require.Equal(t, int64(1), ctx.HeaderInfo().Height)
+
+	// writes made by queries do not reach the check state
+	setIntOnStore(ctx.KVStore(capKey1), counterKey, 10)
+	ctx, err = suite.baseApp.CreateQueryContext(baseapp.CheckStateQueryHeight, false)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), getIntFromStore(t, ctx.KVStore(capKey1), counterKey))
+
+	_, err = suite.baseApp.CreateQueryContext(baseapp.CheckStateQueryHeight, true)
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+}
+
+// newHistoricalQueryApp returns an app with two committed blocks whose database
+// counts commit info reads.
+func newHistoricalQueryApp(tb testing.TB) (*baseapp.BaseApp, *atomic.Int64) {
+	tb.Helper()
+
+	reads := &atomic.Int64{}
+	db := commitInfoReadsDB{DB: dbm.NewMemDB(), reads: reads}
+	app := baseapp.NewBaseApp(tb.Name(), log.NewNopLogger(), db, nil, baseapp.SetChainID("test-chain-id"))
+
+	for height := int64(1); height <= 2; height++ {
+		_, err := app.FinalizeBlock(&abci.RequestFinalizeBlock{Height: height, Time: time.Unix(height*100, 0)})
+		require.NoError(tb, err)
+		_, err = app.Commit()
+		require.NoError(tb, err)
+	}
+
+	return app, reads
+}
+
+func TestABCI_CreateQueryContext_CachesBlockTime(t *testing.T) {
+	app, reads := newHistoricalQueryApp(t)
+
+	reads.Store(0)
+	for i := 0; i < 3; i++ {
+		ctx, err := app.CreateQueryContext(1, false)
+		require.NoError(t, err)
+		require.Equal(t, time.Unix(100, 0).UTC(), ctx.HeaderInfo().Time.UTC())
+		require.Equal(t, "test-chain-id", ctx.HeaderInfo().ChainID)
+		require.Equal(t, int64(1), ctx.HeaderInfo().Height)
+	}
+
+	// only the first query reads the commit info from the store
+	require.Equal(t, int64(1), reads.Load())
+}
+
+func BenchmarkCreateQueryContext_HistoricalHeight(b *testing.B) {
+	app, reads := newHistoricalQueryApp(b)
+
+	reads.Store(0)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := app.CreateQueryContext(1, false); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+
+	b.ReportMetric(float64(reads.Load())/float64(b.N), "commit-info-reads/op")
+}
+
 func TestSetMinGasPrices(t *testing.T) {
 	minGasPrices := sdk.DecCoins{sdk.NewInt64DecCoin("stake", 5000)}
 	suite := NewBaseAppSuite(t, baseapp.SetMinGasPrices(minGasPrices.String()))
@@ -828,6 +1208,52 @@ func TestGetEmptyConsensusParams(t *testing.T) {
 	require.Equal(t, uint64(0), suite.baseApp.GetMaximumBlockGas(ctx))
 }
 
+func TestStoreConsensusParams_EvidenceAgeConsistency(t *testing.T) {
+	evidence := func(numBlocks int64, duration time.Duration) cmtproto.ConsensusParams {
+		return cmtproto.ConsensusParams{Evidence: &cmtproto.EvidenceParams{MaxAgeNumBlocks: numBlocks, MaxAgeDuration: duration}}
+	}
+
+	testCases := []struct {
+		name         string
+		avgBlockTime time.Duration
+		strict       bool
+		cp           cmtproto.ConsensusParams
+		expErr       bool
+		expWarn      bool
+	}{
+		{"consistent", 5 * time.Second, true, evidence(100000, 140*time.Hour), false, false},
+		{"consistent within factor", 5 * time.Second, true, evidence(100000, 250*time.Hour), false, false},
+		{"duration too short", 5 * time.Second, true, evidence(100000, time.Hour), true, false},
+		{"duration too long", 5 * time.Second, true, evidence(10, 48*time.Hour), true, false},
+		{"inconsistent without strict mode", 5 * time.Second, false, evidence(100000, time.Hour), false, true},
+		{"check disabled", 0, true, evidence(100000, time.Hour), false, false},
+		{"no evidence params", 5 * time.Second, true, cmtproto.ConsensusParams{}, false, false},
+		{"unset max age duration", 5 * time.Second, true, evidence(100000, 0), false, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			suite := NewBaseAppSuite(t, baseapp.SetEvidenceAgeConsistencyCheck(tc.avgBlockTime, tc.strict))
+			ctx := suite.baseApp.NewContext(true)
+
+			err := suite.baseApp.StoreConsensusParams(ctx, tc.cp)
+			if tc.expErr {
+				require.ErrorContains(t, err, "is inconsistent with the evidence max age duration")
+				require.Equal(t, cmtproto.ConsensusParams{}, suite.baseApp.GetConsensusParams(ctx))
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.cp, suite.baseApp.GetConsensusParams(ctx))
+			if tc.expWarn {
+				require.Contains(t, suite.logBuffer.String(), "inconsistent evidence consensus params")
+			} else {
+				require.NotContains(t, suite.logBuffer.String(), "inconsistent evidence consensus params")
+			}
+		})
+	}
+}
+
 func TestLoadVersionPruning(t *testing.T) {
 	logger := log.NewNopLogger()
 	pruningOptions := pruningtypes.NewCustomPruningOptions(10, 15)
@@ -0,0 +1,35 @@
+package baseapp
+
+import storetypes "cosmossdk.io/store/types"
+
+// BlockGasInfo holds the gas consumed by the txs of a block, as tracked by the
+// block gas meter, and the block gas limit, which is math.MaxUint64 when the
+// block gas is not limited. It is the JSON encoded response of the
+// /app/block-gas query.
+type BlockGasInfo struct {
+	Height   int64  `json:"height"`
+	Consumed uint64 `json:"consumed"`
+	Limit    uint64 `json:"limit"`
+	// InProgress reports whether the block is still being finalized, in which
+	// case Consumed only accounts for the txs executed so far.
+	InProgress bool `json:"in_progress"`
+}
+
+// CurrentBlockGasInfo returns the block gas info of the block being finalized,
+// as of its last executed tx, or the totals of the last finalized block when no
+// block is being finalized. It is safe to call concurrently with FinalizeBlock.
+// It returns nil if no block was finalized yet.
+func (app *BaseApp) CurrentBlockGasInfo() *BlockGasInfo {
+	return app.blockGasInfo.Load()
+}
+
+// recordBlockGasInfo publishes the consumption of the block gas meter of the
+// block at the given height.
+func (app *BaseApp) recordBlockGasInfo(height int64, gasMeter storetypes.GasMeter, inProgress bool) {
+	app.blockGasInfo.Store(&BlockGasInfo{
+		Height:     height,
+		Consumed:   gasMeter.GasConsumed(),
+		Limit:      gasMeter.Limit(),
+		InProgress: inProgress,
+	})
+}
@@ -0,0 +1,47 @@
+package baseapp
+
+import "time"
+
+// BlockProfile holds the wall-clock durations of the phases of a finalized
+// block. It is the JSON encoded response of the /app/block-profile query, in
+// which durations are expressed in nanoseconds.
+type BlockProfile struct {
+	Height      int64         `json:"height"`
+	NumTxs      int           `json:"num_txs"`
+	PreBlock    time.Duration `json:"pre_block"`
+	BeginBlock  time.Duration `json:"begin_block"`
+	Txs         time.Duration `json:"txs"`
+	EndBlock    time.Duration `json:"end_block"`
+	WorkingHash time.Duration `json:"working_hash"`
+}
+
+// LastBlockProfile returns the profile of the last finalized block, or nil if
+// block profiling is disabled or no block was finalized yet.
+func (app *BaseApp) LastBlockProfile() *BlockProfile {
+	return app.lastBlockProfile.Load()
+}
+
+// recordBlockProfile completes the profile assembled by internalFinalizeBlock
+// with the duration of the working hash computation, logs it and makes it
+// available as the last block profile.
+func (app *BaseApp) recordBlockProfile(workingHash time.Duration) {
+	profile := app.pendingBlockProfile
+	app.pendingBlockProfile = nil
+	if !app.blockProfiling || profile == nil {
+		return
+	}
+
+	profile.WorkingHash = workingHash
+	app.lastBlockProfile.Store(profile)
+
+	app.logger.Info(
+		"block profile",
+		"height", profile.Height,
+		"num_txs", profile.NumTxs,
+		"pre_block", profile.PreBlock,
+		"begin_block", profile.BeginBlock,
+		"txs", profile.Txs,
+		"end_block", profile.EndBlock,
+		"working_hash", profile.WorkingHash,
+	)
+}
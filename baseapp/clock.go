@@ -0,0 +1,20 @@
+package baseapp
+
+import "time"
+
+// Clock is the source of wall-clock time of BaseApp, used e.g. to wait for the
+// halt grace period or to bound proposal times. It can be replaced with
+// SetClock to drive time-dependent behavior deterministically in tests.
+type Clock interface {
+	// Now returns the current time.
+	Now() time.Time
+	// Sleep pauses the caller for at least the given duration.
+	Sleep(d time.Duration)
+}
+
+// realClock is the default Clock, backed by the time package.
+type realClock struct{}
+
+func (realClock) Now() time.Time { return time.Now() }
+
+func (realClock) Sleep(d time.Duration) { time.Sleep(d) }
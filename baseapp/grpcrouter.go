@@ -3,6 +3,7 @@ package baseapp
 import (
 	"context"
 	"fmt"
+	"sort"
 
 	abci "github.com/cometbft/cometbft/abci/types"
 	gogogrpc "github.com/cosmos/gogoproto/grpc"
@@ -65,6 +66,17 @@ func (qrt *GRPCQueryRouter) Route(path string) GRPCQueryHandler {
 	return handler
 }
 
+// Routes returns the sorted full method names of the registered query
+// handlers, e.g. "/cosmos.bank.v1beta1.Query/Balance".
+func (qrt *GRPCQueryRouter) Routes() []string {
+	routes := make([]string, 0, len(qrt.routes))
+	for route := range qrt.routes {
+		routes = append(routes, route)
+	}
+	sort.Strings(routes)
+	return routes
+}
+
 // RegisterService implements the gRPC Server.RegisterService method. sd is a gRPC
 // service description, handler is an object which implements that gRPC service/
 //
@@ -2,9 +2,11 @@ package baseapp_test
 
 import (
 	"context"
+	"encoding/json"
 	"sync"
 	"testing"
 
+	abci "github.com/cometbft/cometbft/abci/types"
 	dbm "github.com/cosmos/cosmos-db"
 	"github.com/stretchr/testify/require"
 
@@ -97,6 +99,28 @@ func TestGRPCRouterHybridHandlers(t *testing.T) {
 	})
 }
 
+func TestGRPCQueryRouterRoutes(t *testing.T) {
+	suite := NewBaseAppSuite(t)
+	testdata_pulsar.RegisterQueryServer(suite.baseApp.GRPCQueryRouter(), testdata_pulsar.QueryImpl{})
+
+	expRoutes := []string{
+		"/cosmos.base.reflection.v1beta1.ReflectionService/ListAllInterfaces",
+		"/cosmos.base.reflection.v1beta1.ReflectionService/ListImplementations",
+		"/testpb.Query/Echo",
+		"/testpb.Query/SayHello",
+		"/testpb.Query/TestAny",
+	}
+	require.Equal(t, expRoutes, suite.baseApp.GRPCQueryRouter().Routes())
+
+	res, err := suite.baseApp.Query(context.TODO(), &abci.RequestQuery{Path: "/app/routes"})
+	require.NoError(t, err)
+	require.True(t, res.IsOK(), res.Log)
+
+	var routes []string
+	require.NoError(t, json.Unmarshal(res.Value, &routes))
+	require.Equal(t, expRoutes, routes)
+}
+
 func TestRegisterQueryServiceTwice(t *testing.T) {
 	// Setup baseapp.
 	var appBuilder *runtime.AppBuilder
@@ -25,49 +25,27 @@ func (app *BaseApp) RegisterGRPCServer(server gogogrpc.Server) {
 	// Define an interceptor for all gRPC queries: this interceptor will create
 	// a new sdk.Context, and pass it into the query handler.
 	interceptor := func(grpcCtx context.Context, req interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
-		// If there's some metadata in the context, retrieve it.
-		md, ok := metadata.FromIncomingContext(grpcCtx)
-		if !ok {
-			return nil, status.Error(codes.Internal, "unable to retrieve metadata")
-		}
-
-		// Get height header from the request context, if present.
-		var height int64
-		if heightHeaders := md.Get(grpctypes.GRPCBlockHeightHeader); len(heightHeaders) == 1 {
-			height, err = strconv.ParseInt(heightHeaders[0], 10, 64)
-			if err != nil {
-				return nil, errorsmod.Wrapf(
-					sdkerrors.ErrInvalidRequest,
-					"Baseapp.RegisterGRPCServer: invalid height header %q: %v", grpctypes.GRPCBlockHeightHeader, err)
-			}
-			if err := checkNegativeHeight(height); err != nil {
-				return nil, err
-			}
-		}
-
-		// Create the sdk.Context. Passing false as 2nd arg, as we can't
-		// actually support proofs with gRPC right now.
-		sdkCtx, err := app.CreateQueryContext(height, false)
+		grpcCtx, err = app.grpcQueryContext(grpcCtx)
 		if err != nil {
 			return nil, err
 		}
 
-		// Add relevant gRPC headers
-		if height == 0 {
-			height = sdkCtx.BlockHeight() // If height was not set in the request, set it to the latest
-		}
+		app.logger.Debug("gRPC query received of type: " + fmt.Sprintf("%#v", req))
 
-		// Attach the sdk.Context into the gRPC's context.Context.
-		grpcCtx = context.WithValue(grpcCtx, sdk.SdkContextKey, sdkCtx)
+		return handler(grpcCtx, req)
+	}
 
-		md = metadata.Pairs(grpctypes.GRPCBlockHeightHeader, strconv.FormatInt(height, 10))
-		if err = grpc.SetHeader(grpcCtx, md); err != nil {
-			app.logger.Error("failed to set gRPC header", "err", err)
+	// Server-streaming queries get the sdk.Context through the context of
+	// their stream.
+	streamInterceptor := func(srv interface{}, stream grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		grpcCtx, err := app.grpcQueryContext(stream.Context())
+		if err != nil {
+			return err
 		}
 
-		app.logger.Debug("gRPC query received of type: " + fmt.Sprintf("%#v", req))
-
-		return handler(grpcCtx, req)
+		wrapped := grpcmiddleware.WrapServerStream(stream)
+		wrapped.WrappedContext = grpcCtx
+		return handler(srv, wrapped)
 	}
 
 	// Loop through all services and methods, add the interceptor, and register
@@ -89,14 +67,81 @@ func (app *BaseApp) RegisterGRPCServer(server gogogrpc.Server) {
 			}
 		}
 
+		newStreams := make([]grpc.StreamDesc, len(desc.Streams))
+		for i, stream := range desc.Streams {
+			streamHandler := stream.Handler
+			info := &grpc.StreamServerInfo{
+				FullMethod:     fmt.Sprintf("/%s/%s", desc.ServiceName, stream.StreamName),
+				IsClientStream: stream.ClientStreams,
+				IsServerStream: stream.ServerStreams,
+			}
+			newStreams[i] = stream
+			newStreams[i].Handler = func(srv interface{}, serverStream grpc.ServerStream) error {
+				return grpcmiddleware.ChainStreamServer(
+					grpcrecovery.StreamServerInterceptor(),
+					streamInterceptor,
+				)(srv, serverStream, info, streamHandler)
+			}
+		}
+
 		newDesc := &grpc.ServiceDesc{
 			ServiceName: desc.ServiceName,
 			HandlerType: desc.HandlerType,
 			Methods:     newMethods,
-			Streams:     desc.Streams,
+			Streams:     newStreams,
 			Metadata:    desc.Metadata,
 		}
 
 		server.RegisterService(newDesc, data.handler)
 	}
 }
+
+// grpcQueryContext creates the sdk.Context of a gRPC query at the height given
+// by the block height header of the request, attaches it to the gRPC context
+// and sets the height header of the response.
+func (app *BaseApp) grpcQueryContext(grpcCtx context.Context) (context.Context, error) {
+	// If there's some metadata in the context, retrieve it.
+	md, ok := metadata.FromIncomingContext(grpcCtx)
+	if !ok {
+		return nil, status.Error(codes.Internal, "unable to retrieve metadata")
+	}
+
+	// Get height header from the request context, if present.
+	var height int64
+	if heightHeaders := md.Get(grpctypes.GRPCBlockHeightHeader); len(heightHeaders) == 1 {
+		var err error
+		height, err = strconv.ParseInt(heightHeaders[0], 10, 64)
+		if err != nil {
+			return nil, errorsmod.Wrapf(
+				sdkerrors.ErrInvalidRequest,
+				"Baseapp.RegisterGRPCServer: invalid height header %q: %v", grpctypes.GRPCBlockHeightHeader, err)
+		}
+		if height != CheckStateQueryHeight || !app.checkStateQueries {
+			if err := checkNegativeHeight(height); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	// Create the sdk.Context. Passing false as 2nd arg, as we can't
+	// actually support proofs with gRPC right now.
+	sdkCtx, err := app.CreateQueryContext(height, false)
+	if err != nil {
+		return nil, err
+	}
+
+	// Add relevant gRPC headers
+	if height == 0 {
+		height = sdkCtx.BlockHeight() // If height was not set in the request, set it to the latest
+	}
+
+	// Attach the sdk.Context into the gRPC's context.Context.
+	grpcCtx = context.WithValue(grpcCtx, sdk.SdkContextKey, sdkCtx)
+
+	md = metadata.Pairs(grpctypes.GRPCBlockHeightHeader, strconv.FormatInt(height, 10))
+	if err = grpc.SetHeader(grpcCtx, md); err != nil {
+		app.logger.Error("failed to set gRPC header", "err", err)
+	}
+
+	return grpcCtx, nil
+}
@@ -0,0 +1,37 @@
+package baseapp
+
+import "time"
+
+// DefaultHealthMaxBlockAge is the default maximum age of the last committed
+// block for the app to be reported as active by the /app/health query.
+const DefaultHealthMaxBlockAge = time.Minute
+
+// Health is the JSON encoded response of the /app/health query.
+type Health struct {
+	LastBlockHeight int64     `json:"last_block_height"`
+	LastBlockTime   time.Time `json:"last_block_time"`
+	// Active reports whether the last block was committed recently, i.e. its
+	// time is within the configured maximum block age. It is false while the
+	// node is catching up or once the chain halted.
+	Active bool `json:"active"`
+}
+
+// health returns the health of the app as of the given time. It only reads
+// the last committed block from memory and does not access the store.
+func (app *BaseApp) health(now time.Time) Health {
+	res := Health{LastBlockHeight: app.LastBlockHeight()}
+
+	info, ok := app.LatestCheckStateInfo()
+	if !ok || res.LastBlockHeight == 0 {
+		return res
+	}
+
+	maxBlockAge := app.healthMaxBlockAge
+	if maxBlockAge == 0 {
+		maxBlockAge = DefaultHealthMaxBlockAge
+	}
+
+	res.LastBlockTime = info.Time
+	res.Active = now.Sub(info.Time) <= maxBlockAge
+	return res
+}
@@ -0,0 +1,46 @@
+package baseapp
+
+import (
+	"fmt"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+)
+
+// EffectiveLimit is a limit enforced by a module on top of the consensus
+// params, e.g. a maximum message size.
+type EffectiveLimit struct {
+	Module      string `json:"module"`
+	Name        string `json:"name"`
+	Value       int64  `json:"value"`
+	Description string `json:"description,omitempty"`
+}
+
+// EffectiveLimits is the JSON encoded response of the /app/effective-limits
+// query, aggregating the consensus params with the limits declared by modules.
+type EffectiveLimits struct {
+	ConsensusParams cmtproto.ConsensusParams `json:"consensus_params"`
+	ModuleLimits    []EffectiveLimit         `json:"module_limits"`
+}
+
+// RegisterEffectiveLimits declares limits enforced by modules, so that they are
+// reported by the /app/effective-limits query. It panics if a limit has no
+// module or name, or if the same module limit is declared twice.
+func (app *BaseApp) RegisterEffectiveLimits(limits ...EffectiveLimit) {
+	if app.sealed {
+		panic("RegisterEffectiveLimits() on sealed BaseApp")
+	}
+
+	for _, limit := range limits {
+		if limit.Module == "" || limit.Name == "" {
+			panic(fmt.Sprintf("effective limit must have a module and a name: %+v", limit))
+		}
+
+		for _, registered := range app.effectiveLimits {
+			if registered.Module == limit.Module && registered.Name == limit.Name {
+				panic(fmt.Sprintf("effective limit %s/%s already registered", limit.Module, limit.Name))
+			}
+		}
+
+		app.effectiveLimits = append(app.effectiveLimits, limit)
+	}
+}
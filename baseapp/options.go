@@ -6,7 +6,9 @@ import (
 	"fmt"
 	"io"
 	"math"
+	"time"
 
+	abci "github.com/cometbft/cometbft/abci/types"
 	dbm "github.com/cosmos/cosmos-db"
 
 	"cosmossdk.io/store/metrics"
@@ -59,6 +61,17 @@ func SetHaltTime(haltTime uint64) func(*BaseApp) {
 	return func(bapp *BaseApp) { bapp.setHaltTime(haltTime) }
 }
 
+// SetClock sets the source of wall-clock time of the BaseApp.
+func SetClock(clock Clock) func(*BaseApp) {
+	return func(app *BaseApp) { app.SetClock(clock) }
+}
+
+// SetHaltGracePeriod returns a BaseApp option function that sets the grace
+// period before halting once the halt height or time is reached.
+func SetHaltGracePeriod(gracePeriod time.Duration) func(*BaseApp) {
+	return func(bapp *BaseApp) { bapp.SetHaltGracePeriod(gracePeriod) }
+}
+
 // SetMinRetainBlocks returns a BaseApp option function that sets the minimum
 // block retention height value when determining which heights to prune during
 // ABCI Commit.
@@ -97,6 +110,153 @@ func SetSnapshot(snapshotStore *snapshots.Store, opts snapshottypes.SnapshotOpti
 	return func(app *BaseApp) { app.SetSnapshot(snapshotStore, opts) }
 }
 
+// SetSnapshotChunkMaxRejects sets the maximum number of checksum mismatches
+// tolerated for a single snapshot chunk before state sync is aborted.
+func SetSnapshotChunkMaxRejects(maxRejects uint32) func(*BaseApp) {
+	return func(app *BaseApp) { app.SetSnapshotChunkMaxRejects(maxRejects) }
+}
+
+// SetQueryPanicRethrow sets whether panics raised while handling a Query are
+// rethrown instead of being recovered.
+func SetQueryPanicRethrow(rethrow bool) func(*BaseApp) {
+	return func(app *BaseApp) { app.SetQueryPanicRethrow(rethrow) }
+}
+
+// SetQueryErrorInfo sets whether failed query responses describe their error
+// in the response Info.
+func SetQueryErrorInfo(enabled bool) func(*BaseApp) {
+	return func(app *BaseApp) { app.SetQueryErrorInfo(enabled) }
+}
+
+// SetCoalesceBeginBlockEvents sets whether begin block events identical to the
+// previous block's are dropped.
+func SetCoalesceBeginBlockEvents(enable bool) func(*BaseApp) {
+	return func(app *BaseApp) { app.SetCoalesceBeginBlockEvents(enable) }
+}
+
+// SetLogHaltEvaluation sets whether each evaluation of the halt conditions is
+// logged at debug level.
+func SetLogHaltEvaluation(enable bool) func(*BaseApp) {
+	return func(app *BaseApp) { app.SetLogHaltEvaluation(enable) }
+}
+
+// SetAllowGenesisValidatorsMismatch sets whether InitChain tolerates a mismatch
+// between the requested and the genesis validators.
+func SetAllowGenesisValidatorsMismatch(allow bool) func(*BaseApp) {
+	return func(app *BaseApp) { app.SetAllowGenesisValidatorsMismatch(allow) }
+}
+
+// SetInitChainDefaultConsensusParams sets whether InitChain stores default
+// consensus params when the request carries none.
+func SetInitChainDefaultConsensusParams(enabled bool) func(*BaseApp) {
+	return func(app *BaseApp) { app.SetInitChainDefaultConsensusParams(enabled) }
+}
+
+// SetCommitIDQuery enables or disables the "/app/commit-id" query.
+func SetCommitIDQuery(enabled bool) func(*BaseApp) {
+	return func(app *BaseApp) { app.SetCommitIDQuery(enabled) }
+}
+
+// SetQueryableStores restricts "/store" queries to the given stores.
+func SetQueryableStores(storeNames ...string) func(*BaseApp) {
+	return func(app *BaseApp) { app.SetQueryableStores(storeNames...) }
+}
+
+// SetListenerErrorsLimit sets the number of ABCI listener errors collected for
+// DrainListenerErrors.
+func SetListenerErrorsLimit(limit int) func(*BaseApp) {
+	return func(app *BaseApp) { app.SetListenerErrorsLimit(limit) }
+}
+
+// SetPanicOnListenerError sets whether failing ABCI listener hooks panic
+// instead of being logged.
+func SetPanicOnListenerError(enabled bool) func(*BaseApp) {
+	return func(app *BaseApp) { app.SetPanicOnListenerError(enabled) }
+}
+
+// SetValidatorUpdatesDiffLimit enables per block validator updates diffs for
+// validator sets of up to maxValidators validators.
+func SetValidatorUpdatesDiffLimit(maxValidators int) func(*BaseApp) {
+	return func(app *BaseApp) { app.SetValidatorUpdatesDiffLimit(maxValidators) }
+}
+
+// SetBlockProfiling enables or disables recording the duration of each phase
+// of finalized blocks.
+func SetBlockProfiling(enabled bool) func(*BaseApp) {
+	return func(app *BaseApp) { app.SetBlockProfiling(enabled) }
+}
+
+// SetRetainAllBlocksWithoutEvidenceParams sets whether no blocks are pruned
+// while the consensus params have no evidence params.
+func SetRetainAllBlocksWithoutEvidenceParams(enabled bool) func(*BaseApp) {
+	return func(app *BaseApp) { app.SetRetainAllBlocksWithoutEvidenceParams(enabled) }
+}
+
+// SetEvidenceAgeConsistencyCheck enables checking the consistency of the
+// evidence max ages of stored consensus params at the given average block time.
+func SetEvidenceAgeConsistencyCheck(avgBlockTime time.Duration, strict bool) func(*BaseApp) {
+	return func(app *BaseApp) { app.SetEvidenceAgeConsistencyCheck(avgBlockTime, strict) }
+}
+
+// SetAppHashEvent enables or disables the app hash event of FinalizeBlock.
+func SetAppHashEvent(enabled bool) func(*BaseApp) {
+	return func(app *BaseApp) { app.SetAppHashEvent(enabled) }
+}
+
+// SetValidateProposerAddress enables or disables the proposer address
+// validation in ProcessProposal.
+func SetValidateProposerAddress(enabled bool) func(*BaseApp) {
+	return func(app *BaseApp) { app.SetValidateProposerAddress(enabled) }
+}
+
+// SetMaxProposalTimeSkew sets how far ahead of the local clock the block time
+// seen by the PrepareProposal handler can be.
+func SetMaxProposalTimeSkew(skew time.Duration) func(*BaseApp) {
+	return func(app *BaseApp) { app.SetMaxProposalTimeSkew(skew) }
+}
+
+// SetRejectOversizedProposals sets whether PrepareProposal fails, instead of
+// trimming them, when the proposed txs exceed the max tx bytes.
+func SetRejectOversizedProposals(reject bool) func(*BaseApp) {
+	return func(app *BaseApp) { app.SetRejectOversizedProposals(reject) }
+}
+
+// SetCheckStateQueries enables or disables queries against the uncommitted
+// check state.
+func SetCheckStateQueries(enabled bool) func(*BaseApp) {
+	return func(app *BaseApp) { app.SetCheckStateQueries(enabled) }
+}
+
+// SetQueryPruningWarningWindow sets the number of blocks ahead of the pruning
+// cutoff within which queries are annotated with a pruning warning.
+func SetQueryPruningWarningWindow(blocks uint64) func(*BaseApp) {
+	return func(app *BaseApp) { app.SetQueryPruningWarningWindow(blocks) }
+}
+
+// SetMaxQueryLag sets how many blocks behind the last block height queries
+// can go.
+func SetMaxQueryLag(blocks uint64) func(*BaseApp) {
+	return func(app *BaseApp) { app.SetMaxQueryLag(blocks) }
+}
+
+// SetQueryMaxResponseBytes sets the maximum size of the value of store range
+// query responses.
+func SetQueryMaxResponseBytes(maxBytes uint64) func(*BaseApp) {
+	return func(app *BaseApp) { app.SetQueryMaxResponseBytes(maxBytes) }
+}
+
+// SetMaxFinalizeBlockDuration sets the maximum duration of the synchronous
+// execution of a block by FinalizeBlock.
+func SetMaxFinalizeBlockDuration(d time.Duration) func(*BaseApp) {
+	return func(app *BaseApp) { app.SetMaxFinalizeBlockDuration(d) }
+}
+
+// SetHealthMaxBlockAge sets the maximum age of the last committed block for
+// the app to be reported as active by the health query.
+func SetHealthMaxBlockAge(maxAge time.Duration) func(*BaseApp) {
+	return func(app *BaseApp) { app.SetHealthMaxBlockAge(maxAge) }
+}
+
 // SetMempool sets the mempool on BaseApp.
 func SetMempool(mempool mempool.Mempool) func(*BaseApp) {
 	return func(app *BaseApp) { app.SetMempool(mempool) }
@@ -119,6 +279,24 @@ func SetOptimisticExecution(opts ...func(*oe.OptimisticExecution)) func(*BaseApp
 	}
 }
 
+// SetOptimisticExecutionFilter sets the predicate deciding, per proposer, whether
+// an accepted proposal is executed optimistically.
+func SetOptimisticExecutionFilter(filter func(proposer []byte) bool) func(*BaseApp) {
+	return func(app *BaseApp) { app.SetOptimisticExecutionFilter(filter) }
+}
+
+// SetStrictFinalizeBlockDecoding sets whether FinalizeBlock fails on
+// transactions that cannot be decoded.
+func SetStrictFinalizeBlockDecoding(strict bool) func(*BaseApp) {
+	return func(app *BaseApp) { app.SetStrictFinalizeBlockDecoding(strict) }
+}
+
+// SetAppHashMixer sets the function deriving the app hash from the working
+// hash of the multistore.
+func SetAppHashMixer(mixer func(ctx sdk.Context, storeHash []byte) []byte) func(*BaseApp) {
+	return func(app *BaseApp) { app.SetAppHashMixer(mixer) }
+}
+
 func (app *BaseApp) SetName(name string) {
 	if app.sealed {
 		panic("SetName() on sealed BaseApp")
@@ -146,11 +324,23 @@ func (app *BaseApp) SetVersion(v string) {
 
 // SetAppVersion sets the application's version this is used as part of the
 // header in blocks and is returned to the consensus engine in EndBlock.
+//
+// The version is persisted in the consensus params, from which both Info and
+// the consensus params queries read it. It can only be set while executing a
+// block, typically from an upgrade handler, so that the new version is
+// committed along with the block state.
 func (app *BaseApp) SetAppVersion(ctx context.Context, v uint64) error {
 	if app.paramStore == nil {
 		return errors.New("param store must be set to set app version")
 	}
 
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	// contexts without an execution mode, e.g. InitChain's, report ExecModeCheck
+	// without being a CheckTx context
+	if sdkCtx.IsCheckTx() || (sdkCtx.ExecMode() != sdk.ExecModeFinalize && sdkCtx.ExecMode() != sdk.ExecModeCheck) {
+		return fmt.Errorf("app version can only be set while executing a block, got exec mode %d", sdkCtx.ExecMode())
+	}
+
 	cp, err := app.paramStore.Get(ctx)
 	if err != nil {
 		return fmt.Errorf("failed to get consensus params: %w", err)
@@ -241,6 +431,18 @@ func (app *BaseApp) SetAnteHandler(ah sdk.AnteHandler) {
 	app.anteHandler = ah
 }
 
+// SetDeterministicSimulateAnteHandler sets the ante handler used instead of the
+// regular one when simulating with the deterministic flag, e.g. a chain without
+// time-dependent decorators. If unset, deterministic simulations use the
+// regular ante handler.
+func (app *BaseApp) SetDeterministicSimulateAnteHandler(ah sdk.AnteHandler) {
+	if app.sealed {
+		panic("SetDeterministicSimulateAnteHandler() on sealed BaseApp")
+	}
+
+	app.deterministicSimulateAnteHandler = ah
+}
+
 func (app *BaseApp) SetPostHandler(ph sdk.PostHandler) {
 	if app.sealed {
 		panic("SetPostHandler() on sealed BaseApp")
@@ -257,12 +459,36 @@ func (app *BaseApp) SetAddrPeerFilter(pf sdk.PeerFilter) {
 	app.addrPeerFilter = pf
 }
 
+// SetIDPeerFilter sets a single filter of peers by node ID. It replaces any
+// filters set with SetIDPeerFilters; a nil filter removes them all.
 func (app *BaseApp) SetIDPeerFilter(pf sdk.PeerFilter) {
 	if app.sealed {
 		panic("SetIDPeerFilter() on sealed BaseApp")
 	}
 
-	app.idPeerFilter = pf
+	if pf == nil {
+		app.idPeerFilters = nil
+		return
+	}
+
+	app.idPeerFilters = []sdk.PeerFilter{pf}
+}
+
+// SetIDPeerFilters sets an ordered chain of filters of peers by node ID, e.g.
+// an allowlist followed by a denylist. FilterPeerByID returns the response of
+// the first filter that rejects the peer and accepts it if none do. Nil
+// filters are ignored.
+func (app *BaseApp) SetIDPeerFilters(pfs ...sdk.PeerFilter) {
+	if app.sealed {
+		panic("SetIDPeerFilters() on sealed BaseApp")
+	}
+
+	app.idPeerFilters = make([]sdk.PeerFilter, 0, len(pfs))
+	for _, pf := range pfs {
+		if pf != nil {
+			app.idPeerFilters = append(app.idPeerFilters, pf)
+		}
+	}
 }
 
 func (app *BaseApp) SetFauxMerkleMode() {
@@ -306,6 +532,415 @@ func (app *BaseApp) SetSnapshot(snapshotStore *snapshots.Store, opts snapshottyp
 	app.snapshotManager = snapshots.NewManager(snapshotStore, opts, app.cms, nil, app.logger)
 }
 
+// SetSnapshotChunkMaxRejects sets the maximum number of checksum mismatches
+// tolerated for a single snapshot chunk. Once exceeded, ApplySnapshotChunk
+// returns ABORT instead of asking CometBFT to refetch the chunk. A value of 0
+// retries indefinitely.
+func (app *BaseApp) SetSnapshotChunkMaxRejects(maxRejects uint32) {
+	if app.sealed {
+		panic("SetSnapshotChunkMaxRejects() on sealed BaseApp")
+	}
+
+	app.snapshotChunkMaxRejects = maxRejects
+}
+
+// SetQueryPanicRethrow sets whether panics raised while handling a Query are
+// logged with their stack trace and rethrown. By default such panics are
+// recovered and returned as ErrPanic query results. Rethrowing is only meant
+// for debugging custom query handlers in tests and local runs.
+func (app *BaseApp) SetQueryPanicRethrow(rethrow bool) {
+	if app.sealed {
+		panic("SetQueryPanicRethrow() on sealed BaseApp")
+	}
+
+	app.queryPanicRethrow = rethrow
+}
+
+// SetQueryErrorInfo sets whether the Info of failed query responses carries
+// the code, codespace and message of the error as a JSON encoded QueryInfo,
+// so that clients can handle errors without parsing the Log. It is disabled
+// by default, leaving the Info of failed queries empty.
+func (app *BaseApp) SetQueryErrorInfo(enabled bool) {
+	if app.sealed {
+		panic("SetQueryErrorInfo() on sealed BaseApp")
+	}
+
+	app.queryErrorInfo = enabled
+}
+
+// SetOptimisticExecutionFilter sets a predicate consulted by ProcessProposal with
+// the proposer address of an accepted proposal. When it returns false the block
+// is not executed optimistically and FinalizeBlock executes it synchronously
+// instead. This allows validators to opt out of optimistic execution for
+// proposers whose proposals are known to be frequently replaced. By default,
+// optimistic execution, when enabled, is used for all proposers.
+func (app *BaseApp) SetOptimisticExecutionFilter(filter func(proposer []byte) bool) {
+	if app.sealed {
+		panic("SetOptimisticExecutionFilter() on sealed BaseApp")
+	}
+
+	app.optimisticExecFilter = filter
+}
+
+// SetStrictFinalizeBlockDecoding sets whether FinalizeBlock returns an error on
+// the first transaction of the block that cannot be decoded. By default such
+// transactions are assumed to be data injected by the proposer, e.g. vote
+// extensions, and are skipped with an ErrTxDecode result. Chains that do not
+// inject data in their proposals can enable strict decoding to fail blocks
+// built from malformed proposals instead.
+func (app *BaseApp) SetStrictFinalizeBlockDecoding(strict bool) {
+	if app.sealed {
+		panic("SetStrictFinalizeBlockDecoding() on sealed BaseApp")
+	}
+
+	app.strictFinalizeBlockDecoding = strict
+}
+
+// SetAppHashMixer sets a function deriving the app hash returned to CometBFT
+// from the working hash of the multistore, e.g. to mix in a commitment to data
+// published on an external data availability layer. If unset, the store hash
+// is used unchanged.
+//
+// The mixer is part of consensus: it must be deterministic and return the same
+// hash on every node for the same block. It is called with the FinalizeBlock
+// context in FinalizeBlock, and again with a query context over the last
+// committed state after a restart, so that the app hash reported to CometBFT
+// matches the one it recorded. Its result must therefore only depend on the
+// committed state, the block height and storeHash. The mixed hash is reported
+// by Info and exposed as the AppHash of the block header in the contexts
+// created by BaseApp, while LastCommitID still reports the store hash.
+func (app *BaseApp) SetAppHashMixer(mixer func(ctx sdk.Context, storeHash []byte) []byte) {
+	if app.sealed {
+		panic("SetAppHashMixer() on sealed BaseApp")
+	}
+
+	app.appHashMixer = mixer
+}
+
+// SetCoalesceBeginBlockEvents sets whether begin block events that are identical
+// to an event of the same type emitted by the previous committed block are
+// dropped from the FinalizeBlock response. This spares indexers from events
+// that modules emit unchanged on every block. The reference events are kept in
+// memory only, so the first block after a restart emits all of its events.
+// It is disabled by default.
+func (app *BaseApp) SetCoalesceBeginBlockEvents(enable bool) {
+	if app.sealed {
+		panic("SetCoalesceBeginBlockEvents() on sealed BaseApp")
+	}
+
+	app.coalesceBeginBlockEvents = enable
+}
+
+// SetLogHaltEvaluation sets whether the halt height and halt time checks run on
+// every FinalizeBlock are logged at debug level, together with the current block
+// height and time, even when the chain does not halt. It is disabled by default
+// to avoid logging on every block.
+func (app *BaseApp) SetLogHaltEvaluation(enable bool) {
+	if app.sealed {
+		panic("SetLogHaltEvaluation() on sealed BaseApp")
+	}
+
+	app.logHaltEvaluation = enable
+}
+
+// SetClock sets the source of wall-clock time used by the BaseApp for the
+// halt grace period, the wait for in-flight snapshots on halt, the proposal
+// time skew bound and the health query. It defaults to the system clock and is
+// mainly meant to be replaced in tests.
+func (app *BaseApp) SetClock(clock Clock) {
+	if app.sealed {
+		panic("SetClock() on sealed BaseApp")
+	}
+
+	app.clock = clock
+}
+
+// SetHaltGracePeriod sets how long FinalizeBlock waits, once the halt height or
+// time is reached, before halting the node. During the grace period a warning
+// about the imminent halt is logged and in-flight work such as snapshots or
+// exports can finish; no block past the halt conditions is executed. It is
+// disabled by default.
+func (app *BaseApp) SetHaltGracePeriod(gracePeriod time.Duration) {
+	if app.sealed {
+		panic("SetHaltGracePeriod() on sealed BaseApp")
+	}
+
+	app.haltGracePeriod = gracePeriod
+}
+
+// SetAllowGenesisValidatorsMismatch sets whether InitChain only logs an error,
+// rather than failing, when the validators returned by the InitChainer differ
+// from the ones provided by CometBFT. This is meant for permissioned chains that
+// intentionally override the genesis validator set.
+func (app *BaseApp) SetAllowGenesisValidatorsMismatch(allow bool) {
+	if app.sealed {
+		panic("SetAllowGenesisValidatorsMismatch() on sealed BaseApp")
+	}
+
+	app.allowGenesisValidatorsMismatch = allow
+}
+
+// SetInitChainDefaultConsensusParams sets whether InitChain stores
+// DefaultConsensusParams when the request carries no consensus params, e.g.
+// because genesis omits them. Otherwise, which is the default, no consensus
+// params are stored and GetConsensusParams returns an empty set.
+func (app *BaseApp) SetInitChainDefaultConsensusParams(enabled bool) {
+	if app.sealed {
+		panic("SetInitChainDefaultConsensusParams() on sealed BaseApp")
+	}
+
+	app.initChainDefaultConsensusParams = enabled
+}
+
+// SetCommitIDQuery enables or disables the "/app/commit-id" query, which
+// returns the last commit ID (version and hash) of the multistore as JSON.
+func (app *BaseApp) SetCommitIDQuery(enabled bool) {
+	if app.sealed {
+		panic("SetCommitIDQuery() on sealed BaseApp")
+	}
+
+	app.commitIDQuery = enabled
+}
+
+// SetQueryableStores sets the names of the stores that can be queried through
+// "/store/<name>/..." ABCI queries. Queries to other stores are rejected with
+// ErrUnauthorized, which allows hiding private module stores. If no store name
+// is given, all stores are queryable, which is the default.
+func (app *BaseApp) SetQueryableStores(storeNames ...string) {
+	if app.sealed {
+		panic("SetQueryableStores() on sealed BaseApp")
+	}
+
+	if len(storeNames) == 0 {
+		app.queryableStores = nil
+		return
+	}
+
+	app.queryableStores = make(map[string]struct{}, len(storeNames))
+	for _, name := range storeNames {
+		app.queryableStores[name] = struct{}{}
+	}
+}
+
+// SetListenerErrorsLimit sets how many errors returned by ABCI listeners, which
+// are otherwise only logged, are kept in memory to be retrieved through
+// DrainListenerErrors. Once the limit is reached the oldest errors are dropped.
+// Errors are not collected if limit is 0, which is the default.
+func (app *BaseApp) SetListenerErrorsLimit(limit int) {
+	if app.sealed {
+		panic("SetListenerErrorsLimit() on sealed BaseApp")
+	}
+
+	app.listenerErrorsLimit = limit
+}
+
+// SetPanicOnListenerError sets whether an error returned by an ABCI listener
+// in FinalizeBlock or Commit panics, stopping the node for strict integrity of
+// the streamed data, instead of being logged and collected for
+// DrainListenerErrors while the node keeps running. It is disabled by default.
+// Note that a Commit listener runs once the state is committed.
+func (app *BaseApp) SetPanicOnListenerError(enabled bool) {
+	if app.sealed {
+		panic("SetPanicOnListenerError() on sealed BaseApp")
+	}
+
+	app.panicOnListenerError = enabled
+}
+
+// SetValidatorUpdatesDiffLimit enables tracking the validator set in memory to
+// compute, for every finalized block, the validators added, removed or whose
+// power changed. The diff of the last block is served as JSON by the
+// "/app/validator-updates-diff" query. The set is seeded from the genesis
+// validators in InitChain and, as it is not persisted, is not tracked after a
+// restart; the query then fails as no diff is available. Tracking stops once
+// the set holds more than maxValidators validators. It is disabled if maxValidators is 0.
+func (app *BaseApp) SetValidatorUpdatesDiffLimit(maxValidators int) {
+	if app.sealed {
+		panic("SetValidatorUpdatesDiffLimit() on sealed BaseApp")
+	}
+
+	app.validatorUpdatesDiffLimit = maxValidators
+}
+
+// SetBlockProfiling sets whether the wall-clock durations of the preBlock,
+// beginBlock, transaction execution, endBlock and working hash phases of every
+// finalized block are recorded. Each block profile is emitted as a single
+// "block profile" log line, and the last one is served as JSON by the
+// "/app/block-profile" query. It is disabled by default.
+func (app *BaseApp) SetBlockProfiling(enabled bool) {
+	if app.sealed {
+		panic("SetBlockProfiling() on sealed BaseApp")
+	}
+
+	app.blockProfiling = enabled
+}
+
+// SetRetainAllBlocksWithoutEvidenceParams sets the block retention height
+// returned while the consensus params have no evidence params, e.g. early in
+// the life of a chain. If enabled, the retention height is 0 and CometBFT
+// retains all blocks, as the blocks needed by light clients to handle evidence
+// are unknown. Otherwise, which is the default, the retention height is
+// derived from the snapshot and min-retain-blocks constraints only.
+func (app *BaseApp) SetRetainAllBlocksWithoutEvidenceParams(enabled bool) {
+	if app.sealed {
+		panic("SetRetainAllBlocksWithoutEvidenceParams() on sealed BaseApp")
+	}
+
+	app.retainAllBlocksWithoutEvidenceParams = enabled
+}
+
+// SetEvidenceAgeConsistencyCheck sets the average block time used to check,
+// when storing consensus params, that the evidence MaxAgeDuration is roughly
+// consistent with MaxAgeNumBlocks, i.e. within a factor 2 of the time
+// MaxAgeNumBlocks blocks take. Otherwise the evidence and block retention
+// windows disagree. Inconsistent params are logged, or rejected by
+// StoreConsensusParams if strict is set. The check is disabled if avgBlockTime
+// is 0, which is the default.
+func (app *BaseApp) SetEvidenceAgeConsistencyCheck(avgBlockTime time.Duration, strict bool) {
+	if app.sealed {
+		panic("SetEvidenceAgeConsistencyCheck() on sealed BaseApp")
+	}
+
+	app.evidenceAgeBlockTime = avgBlockTime
+	app.strictEvidenceAgeConsistency = strict
+}
+
+// SetAppHashEvent sets whether FinalizeBlock appends an EventTypeAppHash event
+// carrying the block height and the hex encoded working app hash to its
+// response events, so that external services, e.g. data availability
+// anchoring, can consume the app hash from ABCI events instead of polling RPC.
+// It is disabled by default.
+func (app *BaseApp) SetAppHashEvent(enabled bool) {
+	if app.sealed {
+		panic("SetAppHashEvent() on sealed BaseApp")
+	}
+
+	app.appHashEvent = enabled
+}
+
+// SetValidateProposerAddress sets whether ProcessProposal rejects proposals
+// whose proposer address does not have the length of a consensus address.
+func (app *BaseApp) SetValidateProposerAddress(enabled bool) {
+	if app.sealed {
+		panic("SetValidateProposerAddress() on sealed BaseApp")
+	}
+
+	app.validateProposerAddress = enabled
+}
+
+// SetMaxProposalTimeSkew sets how far ahead of the local clock the block time
+// of a PrepareProposal request can be. Later times, e.g. from a proposer with a
+// skewed clock, are clamped to the local time plus skew in the context passed
+// to the PrepareProposal handler and a warning is logged. It is disabled by
+// default.
+func (app *BaseApp) SetMaxProposalTimeSkew(skew time.Duration) {
+	if app.sealed {
+		panic("SetMaxProposalTimeSkew() on sealed BaseApp")
+	}
+
+	app.maxProposalTimeSkew = skew
+}
+
+// SetRejectOversizedProposals sets how PrepareProposal handles a response of
+// the PrepareProposal handler whose txs exceed the MaxTxBytes of the request,
+// which CometBFT would refuse to propose. By default the txs that do not fit
+// are trimmed from the end of the proposal and a warning is logged. If reject
+// is true, PrepareProposal returns an error instead and no block is proposed.
+func (app *BaseApp) SetRejectOversizedProposals(reject bool) {
+	if app.sealed {
+		panic("SetRejectOversizedProposals() on sealed BaseApp")
+	}
+
+	app.rejectOversizedProposals = reject
+}
+
+// SetCheckStateQueries sets whether queries made at CheckStateQueryHeight are
+// served from a branch of the check state, i.e. the last committed state plus
+// the effects of the txs accepted by CheckTx since, instead of being rejected
+// as negative height queries. This is meant for mempool-facing tooling; such
+// queries cannot be proven. It is disabled by default.
+func (app *BaseApp) SetCheckStateQueries(enabled bool) {
+	if app.sealed {
+		panic("SetCheckStateQueries() on sealed BaseApp")
+	}
+
+	app.checkStateQueries = enabled
+}
+
+// SetQueryPruningWarningWindow sets the number of blocks ahead of the state
+// pruning cutoff within which gRPC and store queries get a warning appended to
+// their response log. A value of 0 disables the warning.
+func (app *BaseApp) SetQueryPruningWarningWindow(blocks uint64) {
+	if app.sealed {
+		panic("SetQueryPruningWarningWindow() on sealed BaseApp")
+	}
+
+	app.queryPruningWarningWindow = blocks
+}
+
+// SetMaxQueryLag sets the maximum number of blocks a gRPC or store query
+// height can be behind the last block height, to bound how old the state
+// served by the node can be. Queries for older heights fail with
+// ErrInvalidHeight. A value of 0 disables the limit.
+func (app *BaseApp) SetMaxQueryLag(blocks uint64) {
+	if app.sealed {
+		panic("SetMaxQueryLag() on sealed BaseApp")
+	}
+
+	app.maxQueryLag = blocks
+}
+
+// SetQueryMaxResponseBytes sets the maximum size in bytes of the value of
+// "/store/<store>/subspace" query responses. Larger results are truncated to
+// the pairs that fit and flagged as truncated in the JSON encoded QueryInfo of
+// the response Info so that clients can paginate. A value of 0 disables the limit.
+func (app *BaseApp) SetQueryMaxResponseBytes(maxBytes uint64) {
+	if app.sealed {
+		panic("SetQueryMaxResponseBytes() on sealed BaseApp")
+	}
+
+	app.queryMaxResponseBytes = maxBytes
+}
+
+// SetMaxFinalizeBlockDuration sets the maximum duration of the execution of a
+// block by FinalizeBlock when it is not executed optimistically. The deadline is
+// exposed to message handlers through the context of transactions, and checked
+// after BeginBlock, each transaction and EndBlock; once it is exceeded,
+// FinalizeBlock returns an error wrapping context.DeadlineExceeded. Handlers
+// ignoring the context are not interrupted. It is disabled if d is 0, which is
+// the default.
+func (app *BaseApp) SetMaxFinalizeBlockDuration(d time.Duration) {
+	if app.sealed {
+		panic("SetMaxFinalizeBlockDuration() on sealed BaseApp")
+	}
+
+	app.maxFinalizeBlockDuration = d
+}
+
+// SetHealthMaxBlockAge sets the maximum time elapsed since the last committed
+// block time for the app to be reported as active by the "/app/health" query.
+// DefaultHealthMaxBlockAge is used if maxAge is 0.
+func (app *BaseApp) SetHealthMaxBlockAge(maxAge time.Duration) {
+	if app.sealed {
+		panic("SetHealthMaxBlockAge() on sealed BaseApp")
+	}
+
+	app.healthMaxBlockAge = maxAge
+}
+
+// SetDeliverTxEventTransformer sets a function transforming the events of each
+// transaction executed by FinalizeBlock before they are marked for indexing,
+// e.g. to redact or strip large attributes. It applies to the events of failed
+// transactions too. Events are left unchanged if it is nil, which is the
+// default.
+func (app *BaseApp) SetDeliverTxEventTransformer(transformer func([]abci.Event) []abci.Event) {
+	if app.sealed {
+		panic("SetDeliverTxEventTransformer() on sealed BaseApp")
+	}
+
+	app.deliverTxEventTransformer = transformer
+}
+
 // SetInterfaceRegistry sets the InterfaceRegistry.
 func (app *BaseApp) SetInterfaceRegistry(registry types.InterfaceRegistry) {
 	app.interfaceRegistry = registry
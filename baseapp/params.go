@@ -4,6 +4,7 @@ import (
 	"context"
 
 	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/gogoproto/proto"
 )
 
 const InitialAppVersion uint64 = 0
@@ -24,3 +25,15 @@ type AppVersionModifier interface {
 	SetAppVersion(context.Context, uint64) error
 	AppVersion(context.Context) (uint64, error)
 }
+
+// ConsensusParamsEqual reports whether a and b hold the same consensus
+// parameters. Two nil params are equal, and a nil sub-message is not equal to
+// an empty one since CometBFT treats a nil sub-message as "unchanged" in
+// ConsensusParamUpdates.
+func ConsensusParamsEqual(a, b *cmtproto.ConsensusParams) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	return proto.Equal(a, b)
+}
@@ -0,0 +1,49 @@
+package baseapp_test
+
+import (
+	"testing"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+)
+
+func TestConsensusParamsEqual(t *testing.T) {
+	testCases := map[string]struct {
+		a, b  *cmtproto.ConsensusParams
+		equal bool
+	}{
+		"both nil":      {nil, nil, true},
+		"nil and empty": {nil, &cmtproto.ConsensusParams{}, false},
+		"both empty":    {&cmtproto.ConsensusParams{}, &cmtproto.ConsensusParams{}, true},
+		"equal": {
+			&cmtproto.ConsensusParams{Block: &cmtproto.BlockParams{MaxBytes: 100, MaxGas: 10}},
+			&cmtproto.ConsensusParams{Block: &cmtproto.BlockParams{MaxBytes: 100, MaxGas: 10}},
+			true,
+		},
+		"differing field": {
+			&cmtproto.ConsensusParams{Block: &cmtproto.BlockParams{MaxBytes: 100, MaxGas: 10}},
+			&cmtproto.ConsensusParams{Block: &cmtproto.BlockParams{MaxBytes: 100, MaxGas: 20}},
+			false,
+		},
+		"nil and empty sub-message": {
+			&cmtproto.ConsensusParams{Block: nil},
+			&cmtproto.ConsensusParams{Block: &cmtproto.BlockParams{}},
+			false,
+		},
+		"differing sub-messages": {
+			&cmtproto.ConsensusParams{Version: &cmtproto.VersionParams{App: 1}},
+			&cmtproto.ConsensusParams{Evidence: &cmtproto.EvidenceParams{MaxAgeNumBlocks: 1}},
+			false,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.equal, baseapp.ConsensusParamsEqual(tc.a, tc.b))
+			require.Equal(t, tc.equal, baseapp.ConsensusParamsEqual(tc.b, tc.a))
+		})
+	}
+}
@@ -0,0 +1,104 @@
+package baseapp
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// SimulateDeterministic is the /app/simulate path segment requesting a
+// deterministic simulation, e.g. /app/simulate/deterministic or
+// /app/simulate/proto/deterministic.
+const SimulateDeterministic = "deterministic"
+
+// DeterministicSimulateBlockTime is the block time deterministic simulations
+// run at, so that their gas estimates don't depend on the node's latest block.
+var DeterministicSimulateBlockTime = time.Unix(0, 0).UTC()
+
+// deterministicSimulateKey is the context key marking a deterministic
+// simulation.
+type deterministicSimulateKey struct{}
+
+// SimulateBatchRequest is the JSON encoded request data of the
+// /app/simulate-batch query.
+type SimulateBatchRequest struct {
+	// Txs are the encoded transactions to simulate, in order.
+	Txs [][]byte `json:"txs"`
+}
+
+// SimulateBatchResponse is the JSON encoded response of the
+// /app/simulate-batch query.
+type SimulateBatchResponse struct {
+	// GasInfos holds the gas info of each simulated transaction, in order.
+	GasInfos []sdk.GasInfo `json:"gas_infos"`
+	// GasWanted and GasUsed are the totals over all simulated transactions.
+	GasWanted uint64 `json:"gas_wanted"`
+	GasUsed   uint64 `json:"gas_used"`
+}
+
+// simulateBatch simulates the given transactions in order on a single branch
+// of the check state, so that each transaction sees the state changes of the
+// previous ones. The branch is discarded, no state is committed. It fails on
+// the first transaction that fails to simulate.
+func (app *BaseApp) simulateBatch(txs [][]byte) (SimulateBatchResponse, error) {
+	if len(txs) == 0 {
+		return SimulateBatchResponse{}, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "no txs to simulate")
+	}
+
+	res := SimulateBatchResponse{GasInfos: make([]sdk.GasInfo, 0, len(txs))}
+
+	// getContextForTx branches the check state in simulation mode
+	batchCtx := app.getContextForTx(execModeSimulate, nil)
+	for i, txBytes := range txs {
+		ctx := batchCtx.
+			WithTxBytes(txBytes).
+			WithGasMeter(storetypes.NewInfiniteGasMeter()).
+			WithEventManager(sdk.NewEventManager())
+
+		gInfo, _, _, err := app.runTxWithContext(ctx, execModeSimulate, txBytes)
+		if err != nil {
+			return res, errorsmod.Wrapf(err, "failed to simulate tx %d", i)
+		}
+
+		res.GasInfos = append(res.GasInfos, gInfo)
+		res.GasWanted += gInfo.GasWanted
+		res.GasUsed += gInfo.GasUsed
+	}
+
+	return res, nil
+}
+
+// simulateDeterministic simulates the given transaction like Simulate, but at
+// DeterministicSimulateBlockTime and with the deterministic simulation ante
+// handler, if set, so that repeated simulations yield the same gas estimate.
+func (app *BaseApp) simulateDeterministic(txBytes []byte) (sdk.GasInfo, *sdk.Result, error) {
+	ctx := app.getContextForTx(execModeSimulate, txBytes)
+	header := ctx.BlockHeader()
+	header.Time = DeterministicSimulateBlockTime
+	headerInfo := ctx.HeaderInfo()
+	headerInfo.Time = DeterministicSimulateBlockTime
+	ctx = ctx.
+		WithBlockHeader(header).
+		WithHeaderInfo(headerInfo).
+		WithGasMeter(storetypes.NewInfiniteGasMeter()).
+		WithValue(deterministicSimulateKey{}, true)
+
+	gInfo, res, _, err := app.runTxWithContext(ctx, execModeSimulate, txBytes)
+	return gInfo, res, err
+}
+
+// txAnteHandler returns the ante handler to run a transaction with on the given
+// context.
+func (app *BaseApp) txAnteHandler(ctx sdk.Context) sdk.AnteHandler {
+	if app.deterministicSimulateAnteHandler != nil {
+		if deterministic, _ := ctx.Value(deterministicSimulateKey{}).(bool); deterministic {
+			return app.deterministicSimulateAnteHandler
+		}
+	}
+
+	return app.anteHandler
+}
@@ -0,0 +1,144 @@
+package baseapp
+
+import (
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+)
+
+// SnapshotStoreResetter is an optional interface of the CommitMultiStore,
+// implemented by store backends that can be cleared. When it is implemented, a
+// failed state sync snapshot restoration resets the stores and rejects the
+// snapshot, so that CometBFT can offer another one, instead of aborting state
+// sync altogether.
+type SnapshotStoreResetter interface {
+	// ResetStores removes all the data of the stores, leaving them as before
+	// any snapshot restoration.
+	ResetStores() error
+}
+
+// resetSnapshotStores resets the stores after a failed snapshot restoration,
+// and reports whether another snapshot can be restored.
+func (app *BaseApp) resetSnapshotStores() bool {
+	resetter, ok := app.cms.(SnapshotStoreResetter)
+	if !ok {
+		return false
+	}
+
+	if err := resetter.ResetStores(); err != nil {
+		app.logger.Error("failed to reset stores after snapshot restoration failure", "err", err)
+		return false
+	}
+
+	return true
+}
+
+// rejectSnapshotChunk records a checksum mismatch for the given chunk and asks
+// CometBFT to refetch it from another sender, or aborts the restoration if the
+// chunk has been rejected more than snapshotChunkMaxRejects times.
+func (app *BaseApp) rejectSnapshotChunk(req *abci.RequestApplySnapshotChunk, err error) *abci.ResponseApplySnapshotChunk {
+	if app.snapshotChunkRejects == nil {
+		app.snapshotChunkRejects = make(map[uint32]uint32)
+	}
+	app.snapshotChunkRejects[req.Index]++
+
+	rejects := app.snapshotChunkRejects[req.Index]
+	if app.snapshotChunkMaxRejects > 0 && rejects > app.snapshotChunkMaxRejects {
+		app.logger.Error(
+			"chunk checksum mismatch limit exceeded; aborting snapshot restoration",
+			"chunk", req.Index,
+			"sender", req.Sender,
+			"rejects", rejects,
+			"max_rejects", app.snapshotChunkMaxRejects,
+			"err", err,
+		)
+		app.snapshotChunkRejects = nil
+		return &abci.ResponseApplySnapshotChunk{Result: abci.ResponseApplySnapshotChunk_ABORT}
+	}
+
+	app.logger.Error(
+		"chunk checksum mismatch; rejecting sender and requesting refetch",
+		"chunk", req.Index,
+		"sender", req.Sender,
+		"rejects", rejects,
+		"err", err,
+	)
+	return &abci.ResponseApplySnapshotChunk{
+		Result:        abci.ResponseApplySnapshotChunk_RETRY,
+		RefetchChunks: []uint32{req.Index},
+		RejectSenders: []string{req.Sender},
+	}
+}
+
+// haltSnapshotWaitTimeout is how long a halting node waits for an in-flight
+// snapshot to complete before halting.
+const haltSnapshotWaitTimeout = 30 * time.Second
+
+// snapshotWaitPollInterval is how often a halting node checks whether the
+// in-flight snapshot completed.
+const snapshotWaitPollInterval = 100 * time.Millisecond
+
+// SnapshotInProgress returns whether a snapshot started by Commit is currently
+// being taken.
+func (app *BaseApp) SnapshotInProgress() bool {
+	return app.snapshotsInProgress.Load() > 0
+}
+
+// snapshotIfApplicable takes a snapshot in the background if height is a
+// snapshot height, counting it as in progress until it completes. The
+// snapshots.Manager.SnapshotIfApplicable goroutine cannot be awaited, so the
+// snapshot and the pruning of old snapshots are run with the Create and Prune
+// methods of the manager instead.
+//
+// TODO: call snapshots.Manager.SnapshotIfApplicable and report its in-progress
+// state once the store release used by BaseApp exposes it.
+func (app *BaseApp) snapshotIfApplicable(height int64) {
+	if app.snapshotManager == nil {
+		return
+	}
+
+	interval := app.snapshotManager.GetInterval()
+	if height <= 0 || interval == 0 || uint64(height)%interval != 0 {
+		app.logger.Debug("snapshot is skipped", "height", height)
+		return
+	}
+
+	app.snapshotsInProgress.Add(1)
+	go func() {
+		defer app.snapshotsInProgress.Add(-1)
+
+		snapshot, err := app.snapshotManager.Create(uint64(height))
+		if err != nil {
+			app.logger.Error("failed to create state snapshot", "height", height, "err", err)
+			return
+		}
+
+		app.logger.Info("completed state snapshot", "height", height, "format", snapshot.Format)
+
+		if keepRecent := app.snapshotManager.GetKeepRecent(); keepRecent > 0 {
+			if _, err := app.snapshotManager.Prune(keepRecent); err != nil {
+				app.logger.Error("failed to prune state snapshots", "err", err)
+			}
+		}
+	}()
+}
+
+// waitForSnapshot blocks until no snapshot is in progress anymore, or until
+// haltSnapshotWaitTimeout elapses on the clock of the BaseApp.
+func (app *BaseApp) waitForSnapshot() {
+	if !app.SnapshotInProgress() {
+		return
+	}
+
+	app.logger.Info("waiting for in-flight snapshot to complete", "timeout", haltSnapshotWaitTimeout)
+
+	deadline := app.clock.Now().Add(haltSnapshotWaitTimeout)
+	for app.SnapshotInProgress() {
+		if !app.clock.Now().Before(deadline) {
+			app.logger.Error("timed out waiting for in-flight snapshot to complete")
+			return
+		}
+
+		app.clock.Sleep(snapshotWaitPollInterval)
+	}
+}
@@ -2,14 +2,20 @@ package baseapp_test
 
 import (
 	"context"
+	"crypto/sha256"
 	"fmt"
+	"strings"
 	"testing"
+	"time"
 
 	abci "github.com/cometbft/cometbft/abci/types"
 	"github.com/stretchr/testify/require"
 
 	pruningtypes "cosmossdk.io/store/pruning/types"
 	snapshottypes "cosmossdk.io/store/snapshots/types"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
 )
 
 func TestABCI_ListSnapshots(t *testing.T) {
@@ -345,3 +351,262 @@ func TestABCI_ApplySnapshotChunk(t *testing.T) {
 	// the target should now have the same hash as the source
 	require.Equal(t, srcSuite.baseApp.LastCommitID(), targetSuite.baseApp.LastCommitID())
 }
+
+func TestABCI_ApplySnapshotChunk_MaxRejects(t *testing.T) {
+	srcCfg := SnapshotsConfig{
+		blocks:             4,
+		blockTxs:           10,
+		snapshotInterval:   2,
+		snapshotKeepRecent: 2,
+		pruningOpts:        pruningtypes.NewPruningOptions(pruningtypes.PruningNothing),
+	}
+	srcSuite := NewBaseAppSuiteWithSnapshots(t, srcCfg)
+
+	targetCfg := SnapshotsConfig{
+		blocks:             0,
+		blockTxs:           0,
+		snapshotInterval:   2,
+		snapshotKeepRecent: 2,
+		pruningOpts:        pruningtypes.NewPruningOptions(pruningtypes.PruningNothing),
+	}
+	targetSuite := NewBaseAppSuiteWithSnapshots(t, targetCfg, baseapp.SetSnapshotChunkMaxRejects(2))
+
+	respList, err := srcSuite.baseApp.ListSnapshots(&abci.RequestListSnapshots{})
+	require.NoError(t, err)
+	require.NotEmpty(t, respList.Snapshots)
+
+	respOffer, err := targetSuite.baseApp.OfferSnapshot(&abci.RequestOfferSnapshot{Snapshot: respList.Snapshots[0]})
+	require.NoError(t, err)
+	require.Equal(t, &abci.ResponseOfferSnapshot{Result: abci.ResponseOfferSnapshot_ACCEPT}, respOffer)
+
+	// the first two mismatches for the chunk are retried
+	for i := 0; i < 2; i++ {
+		respApply, err := targetSuite.baseApp.ApplySnapshotChunk(&abci.RequestApplySnapshotChunk{
+			Index:  0,
+			Chunk:  []byte{9},
+			Sender: fmt.Sprintf("sender%d", i),
+		})
+		require.NoError(t, err)
+		require.Equal(t, &abci.ResponseApplySnapshotChunk{
+			Result:        abci.ResponseApplySnapshotChunk_RETRY,
+			RefetchChunks: []uint32{0},
+			RejectSenders: []string{fmt.Sprintf("sender%d", i)},
+		}, respApply)
+	}
+
+	// exceeding the limit aborts the restoration
+	respApply, err := targetSuite.baseApp.ApplySnapshotChunk(&abci.RequestApplySnapshotChunk{
+		Index:  0,
+		Chunk:  []byte{9},
+		Sender: "sender2",
+	})
+	require.NoError(t, err)
+	require.Equal(t, &abci.ResponseApplySnapshotChunk{Result: abci.ResponseApplySnapshotChunk_ABORT}, respApply)
+}
+
+// blockingExtension is a snapshot extension that blocks while writing its
+// payload until released.
+type blockingExtension struct {
+	started chan struct{}
+	release chan struct{}
+}
+
+func (e blockingExtension) SnapshotName() string { return "blocking" }
+
+func (e blockingExtension) SnapshotFormat() uint32 { return 1 }
+
+func (e blockingExtension) SupportedFormats() []uint32 { return []uint32{1} }
+
+func (e blockingExtension) SnapshotExtension(uint64, snapshottypes.ExtensionPayloadWriter) error {
+	close(e.started)
+	<-e.release
+	return nil
+}
+
+func (e blockingExtension) RestoreExtension(uint64, uint32, snapshottypes.ExtensionPayloadReader) error {
+	return nil
+}
+
+func TestABCI_SnapshotInProgress(t *testing.T) {
+	ssCfg := SnapshotsConfig{
+		blocks:             1,
+		blockTxs:           1,
+		snapshotInterval:   2,
+		snapshotKeepRecent: 1,
+		pruningOpts:        pruningtypes.NewPruningOptions(pruningtypes.PruningNothing),
+	}
+
+	suite := NewBaseAppSuiteWithSnapshots(t, ssCfg, baseapp.SetHaltHeight(2))
+	require.False(t, suite.baseApp.SnapshotInProgress())
+
+	ext := blockingExtension{started: make(chan struct{}), release: make(chan struct{})}
+	require.NoError(t, suite.baseApp.SnapshotManager().RegisterExtensions(ext))
+
+	_, err := suite.baseApp.FinalizeBlock(&abci.RequestFinalizeBlock{Height: 2})
+	require.NoError(t, err)
+	_, err = suite.baseApp.Commit()
+	require.NoError(t, err)
+
+	<-ext.started
+	require.True(t, suite.baseApp.SnapshotInProgress())
+
+	// halting waits for the in-flight snapshot
+	halted := make(chan error)
+	go func() {
+		_, err := suite.baseApp.FinalizeBlock(&abci.RequestFinalizeBlock{Height: 3})
+		halted <- err
+	}()
+
+	select {
+	case <-halted:
+		t.Fatal("halted before the snapshot completed")
+	case <-time.After(200 * time.Millisecond):
+	}
+
+	close(ext.release)
+	err = <-halted
+	require.Error(t, err)
+	require.True(t, strings.HasPrefix(err.Error(), "halt per configuration"))
+	require.False(t, suite.baseApp.SnapshotInProgress())
+}
+
+func TestABCI_SnapshotInProgress_HaltTimeout(t *testing.T) {
+	ssCfg := SnapshotsConfig{
+		blocks:             1,
+		blockTxs:           1,
+		snapshotInterval:   2,
+		snapshotKeepRecent: 1,
+		pruningOpts:        pruningtypes.NewPruningOptions(pruningtypes.PruningNothing),
+	}
+
+	start := time.Unix(1_700_000_000, 0)
+	clock := newFakeClock(start)
+	suite := NewBaseAppSuiteWithSnapshots(t, ssCfg, baseapp.SetHaltHeight(2), baseapp.SetClock(clock))
+
+	ext := blockingExtension{started: make(chan struct{}), release: make(chan struct{})}
+	require.NoError(t, suite.baseApp.SnapshotManager().RegisterExtensions(ext))
+	defer close(ext.release)
+
+	_, err := suite.baseApp.FinalizeBlock(&abci.RequestFinalizeBlock{Height: 2})
+	require.NoError(t, err)
+	_, err = suite.baseApp.Commit()
+	require.NoError(t, err)
+
+	<-ext.started
+
+	// the snapshot never completes, so halting gives up once the wait timed out
+	_, err = suite.baseApp.FinalizeBlock(&abci.RequestFinalizeBlock{Height: 3})
+	require.Error(t, err)
+	require.True(t, strings.HasPrefix(err.Error(), "halt per configuration"))
+	require.True(t, suite.baseApp.SnapshotInProgress())
+	require.Equal(t, start.Add(30*time.Second), clock.Now())
+}
+
+// resettableMultiStore is a CommitMultiStore implementing
+// baseapp.SnapshotStoreResetter.
+type resettableMultiStore struct {
+	storetypes.CommitMultiStore
+	resets int
+}
+
+func (rs *resettableMultiStore) ResetStores() error {
+	rs.resets++
+	return nil
+}
+
+func TestABCI_SnapshotRestoreRetry(t *testing.T) {
+	srcCfg := SnapshotsConfig{
+		blocks:             4,
+		blockTxs:           10,
+		snapshotInterval:   2,
+		snapshotKeepRecent: 2,
+		pruningOpts:        pruningtypes.NewPruningOptions(pruningtypes.PruningNothing),
+	}
+	srcSuite := NewBaseAppSuiteWithSnapshots(t, srcCfg)
+
+	respList, err := srcSuite.baseApp.ListSnapshots(&abci.RequestListSnapshots{})
+	require.NoError(t, err)
+	require.NotEmpty(t, respList.Snapshots)
+	snapshot := respList.Snapshots[0]
+
+	// a snapshot whose single chunk matches its hash but cannot be restored
+	badChunk := []byte("not a snapshot chunk")
+	badChunkHash := sha256.Sum256(badChunk)
+	m := snapshottypes.Metadata{ChunkHashes: [][]byte{badChunkHash[:]}}
+	metadata, err := m.Marshal()
+	require.NoError(t, err)
+	badSnapshot := &abci.Snapshot{
+		Height: 1, Format: snapshottypes.CurrentFormat, Chunks: 1, Hash: []byte{1, 2, 3}, Metadata: metadata,
+	}
+
+	targetCfg := SnapshotsConfig{
+		blocks:             0,
+		blockTxs:           0,
+		snapshotInterval:   2,
+		snapshotKeepRecent: 2,
+		pruningOpts:        pruningtypes.NewPruningOptions(pruningtypes.PruningNothing),
+	}
+
+	// without store reset support, a failed restoration aborts state sync
+	targetSuite := NewBaseAppSuiteWithSnapshots(t, targetCfg)
+
+	respOffer, err := targetSuite.baseApp.OfferSnapshot(&abci.RequestOfferSnapshot{Snapshot: &abci.Snapshot{
+		Height: 0, Format: snapshottypes.CurrentFormat, Chunks: 1, Hash: []byte{1, 2, 3}, Metadata: metadata,
+	}})
+	require.NoError(t, err)
+	require.Equal(t, abci.ResponseOfferSnapshot_ABORT, respOffer.Result)
+
+	respOffer, err = targetSuite.baseApp.OfferSnapshot(&abci.RequestOfferSnapshot{Snapshot: badSnapshot})
+	require.NoError(t, err)
+	require.Equal(t, abci.ResponseOfferSnapshot_ACCEPT, respOffer.Result)
+
+	respApply, err := targetSuite.baseApp.ApplySnapshotChunk(&abci.RequestApplySnapshotChunk{Index: 0, Chunk: badChunk})
+	require.NoError(t, err)
+	require.Equal(t, abci.ResponseApplySnapshotChunk_ABORT, respApply.Result)
+
+	// with store reset support, the snapshot is rejected and another one can
+	// be restored
+	var cms *resettableMultiStore
+	resettableOpt := func(bapp *baseapp.BaseApp) {
+		cms = &resettableMultiStore{CommitMultiStore: bapp.CommitMultiStore()}
+		bapp.SetCMS(cms)
+	}
+	targetSuite = NewBaseAppSuiteWithSnapshots(t, targetCfg, resettableOpt)
+
+	respOffer, err = targetSuite.baseApp.OfferSnapshot(&abci.RequestOfferSnapshot{Snapshot: &abci.Snapshot{
+		Height: 0, Format: snapshottypes.CurrentFormat, Chunks: 1, Hash: []byte{1, 2, 3}, Metadata: metadata,
+	}})
+	require.NoError(t, err)
+	require.Equal(t, abci.ResponseOfferSnapshot_REJECT, respOffer.Result)
+	require.Equal(t, 1, cms.resets)
+
+	respOffer, err = targetSuite.baseApp.OfferSnapshot(&abci.RequestOfferSnapshot{Snapshot: badSnapshot})
+	require.NoError(t, err)
+	require.Equal(t, abci.ResponseOfferSnapshot_ACCEPT, respOffer.Result)
+
+	respApply, err = targetSuite.baseApp.ApplySnapshotChunk(&abci.RequestApplySnapshotChunk{Index: 0, Chunk: badChunk})
+	require.NoError(t, err)
+	require.Equal(t, abci.ResponseApplySnapshotChunk_REJECT_SNAPSHOT, respApply.Result)
+	require.Equal(t, 2, cms.resets)
+
+	// the second offer is restored successfully
+	respOffer, err = targetSuite.baseApp.OfferSnapshot(&abci.RequestOfferSnapshot{Snapshot: snapshot})
+	require.NoError(t, err)
+	require.Equal(t, abci.ResponseOfferSnapshot_ACCEPT, respOffer.Result)
+
+	for index := uint32(0); index < snapshot.Chunks; index++ {
+		respChunk, err := srcSuite.baseApp.LoadSnapshotChunk(&abci.RequestLoadSnapshotChunk{
+			Height: snapshot.Height,
+			Format: snapshot.Format,
+			Chunk:  index,
+		})
+		require.NoError(t, err)
+
+		respApply, err := targetSuite.baseApp.ApplySnapshotChunk(&abci.RequestApplySnapshotChunk{Index: index, Chunk: respChunk.Chunk})
+		require.NoError(t, err)
+		require.Equal(t, abci.ResponseApplySnapshotChunk_ACCEPT, respApply.Result)
+	}
+
+	require.Equal(t, srcSuite.baseApp.LastCommitID(), targetSuite.baseApp.LastCommitID())
+	require.Equal(t, 2, cms.resets)
+}
@@ -2,6 +2,7 @@ package baseapp
 
 import (
 	"sync"
+	"time"
 
 	storetypes "cosmossdk.io/store/types"
 
@@ -34,3 +35,23 @@ func (st *state) Context() sdk.Context {
 	defer st.mtx.RUnlock()
 	return st.ctx
 }
+
+// StateInfo is an immutable copy of the block information of a state's
+// context, which can be read without synchronizing with block execution.
+type StateInfo struct {
+	Height  int64
+	Time    time.Time
+	ChainID string
+}
+
+// Snapshot returns the block information of the state's context.
+func (st *state) Snapshot() StateInfo {
+	st.mtx.RLock()
+	defer st.mtx.RUnlock()
+	headerInfo := st.ctx.HeaderInfo()
+	return StateInfo{
+		Height:  headerInfo.Height,
+		Time:    headerInfo.Time,
+		ChainID: headerInfo.ChainID,
+	}
+}
@@ -44,6 +44,45 @@ func (app *BaseApp) RegisterStreamingServices(appOpts servertypes.AppOptions, ke
 	return nil
 }
 
+// DrainListenerErrors returns the ABCI listener errors collected since the last
+// call, oldest first, and clears them. Errors are only collected when enabled
+// through SetListenerErrorsLimit.
+func (app *BaseApp) DrainListenerErrors() []error {
+	app.listenerErrorsMtx.Lock()
+	defer app.listenerErrorsMtx.Unlock()
+
+	errs := app.listenerErrors
+	app.listenerErrors = nil
+	return errs
+}
+
+// recordListenerError collects an ABCI listener error, dropping the oldest
+// collected error once listenerErrorsLimit is reached.
+func (app *BaseApp) recordListenerError(err error) {
+	if app.listenerErrorsLimit <= 0 {
+		return
+	}
+
+	app.listenerErrorsMtx.Lock()
+	defer app.listenerErrorsMtx.Unlock()
+
+	if len(app.listenerErrors) >= app.listenerErrorsLimit {
+		app.listenerErrors = app.listenerErrors[len(app.listenerErrors)-app.listenerErrorsLimit+1:]
+	}
+	app.listenerErrors = append(app.listenerErrors, err)
+}
+
+// handleListenerError handles a failed ABCI listener hook. It stops the node by
+// panicking when SetPanicOnListenerError is enabled, and otherwise collects the
+// error and lets the node continue.
+func (app *BaseApp) handleListenerError(err error) {
+	if app.panicOnListenerError {
+		panic(err)
+	}
+
+	app.recordListenerError(err)
+}
+
 // registerStreamingPlugin registers streaming plugins with the BaseApp.
 func (app *BaseApp) registerStreamingPlugin(
 	appOpts servertypes.AppOptions,
@@ -2,6 +2,7 @@ package baseapp_test
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"testing"
 
@@ -38,6 +39,17 @@ func (m *MockABCIListener) ListenCommit(_ context.Context, _ abci.ResponseCommit
 	return nil
 }
 
+// failingABCIListener is an ABCI listener whose hooks always fail.
+type failingABCIListener struct{}
+
+func (failingABCIListener) ListenFinalizeBlock(context.Context, abci.RequestFinalizeBlock, abci.ResponseFinalizeBlock) error {
+	return errors.New("finalize block failure")
+}
+
+func (failingABCIListener) ListenCommit(context.Context, abci.ResponseCommit, []*storetypes.StoreKVPair) error {
+	return errors.New("commit failure")
+}
+
 var distKey1 = storetypes.NewKVStoreKey("distKey1")
 
 func TestABCI_MultiListener_StateChanges(t *testing.T) {
@@ -146,3 +158,106 @@ func Test_Ctx_with_StreamingManager(t *testing.T) {
 		require.NoError(t, err)
 	}
 }
+
+func TestABCI_DrainListenerErrors(t *testing.T) {
+	streamingManagerOpt := func(bapp *baseapp.BaseApp) {
+		bapp.SetStreamingManager(storetypes.StreamingManager{
+			ABCIListeners: []storetypes.ABCIListener{failingABCIListener{}},
+		})
+	}
+	suite := NewBaseAppSuite(t, streamingManagerOpt, baseapp.SetListenerErrorsLimit(3))
+
+	_, err := suite.baseApp.InitChain(&abci.RequestInitChain{
+		ConsensusParams: &tmproto.ConsensusParams{},
+	})
+	require.NoError(t, err)
+	require.Empty(t, suite.baseApp.DrainListenerErrors())
+
+	for height := int64(1); height <= 2; height++ {
+		_, err = suite.baseApp.FinalizeBlock(&abci.RequestFinalizeBlock{Height: height})
+		require.NoError(t, err)
+		_, err = suite.baseApp.Commit()
+		require.NoError(t, err)
+	}
+
+	// only the 3 most recent errors are kept
+	errs := suite.baseApp.DrainListenerErrors()
+	require.Len(t, errs, 3)
+	require.EqualError(t, errs[0], "ListenCommit listening hook failed at height 1: commit failure")
+	require.EqualError(t, errs[1], "ListenFinalizeBlock listening hook failed at height 2: finalize block failure")
+	require.EqualError(t, errs[2], "ListenCommit listening hook failed at height 2: commit failure")
+
+	// draining clears the collected errors
+	require.Empty(t, suite.baseApp.DrainListenerErrors())
+}
+
+func TestABCI_ListenerErrorPanic(t *testing.T) {
+	newSuite := func(opts ...func(*baseapp.BaseApp)) *BaseAppSuite {
+		streamingManagerOpt := func(bapp *baseapp.BaseApp) {
+			bapp.SetStreamingManager(storetypes.StreamingManager{
+				ABCIListeners: []storetypes.ABCIListener{failingABCIListener{}},
+				StopNodeOnErr: true,
+			})
+		}
+		opts = append(opts, streamingManagerOpt, baseapp.SetListenerErrorsLimit(3))
+		suite := NewBaseAppSuite(t, opts...)
+
+		_, err := suite.baseApp.InitChain(&abci.RequestInitChain{
+			ConsensusParams: &tmproto.ConsensusParams{},
+		})
+		require.NoError(t, err)
+		return suite
+	}
+
+	// by default listener failures are logged and the node keeps running,
+	// regardless of the streaming manager StopNodeOnErr setting
+	suite := newSuite()
+	require.NotPanics(t, func() {
+		_, err := suite.baseApp.FinalizeBlock(&abci.RequestFinalizeBlock{Height: 1})
+		require.NoError(t, err)
+		_, err = suite.baseApp.Commit()
+		require.NoError(t, err)
+	})
+	require.Contains(t, suite.logBuffer.String(), "ListenFinalizeBlock listening hook failed")
+	require.Len(t, suite.baseApp.DrainListenerErrors(), 2)
+
+	// listener failures stop the node
+	suite = newSuite(baseapp.SetPanicOnListenerError(true))
+	require.PanicsWithError(t, "ListenFinalizeBlock listening hook failed at height 1: finalize block failure", func() {
+		_, _ = suite.baseApp.FinalizeBlock(&abci.RequestFinalizeBlock{Height: 1})
+	})
+	require.Empty(t, suite.baseApp.DrainListenerErrors())
+}
+
+func TestABCI_FinalizeBlock_ListenerSkippedOnEarlyError(t *testing.T) {
+	mockListener := NewMockABCIListener("lis_1")
+	streamingManagerOpt := func(bapp *baseapp.BaseApp) {
+		bapp.SetStreamingManager(storetypes.StreamingManager{
+			ABCIListeners: []storetypes.ABCIListener{&mockListener},
+		})
+	}
+	suite := NewBaseAppSuite(t, streamingManagerOpt, baseapp.SetHaltHeight(1))
+
+	_, err := suite.baseApp.InitChain(&abci.RequestInitChain{
+		ConsensusParams: &tmproto.ConsensusParams{},
+	})
+	require.NoError(t, err)
+
+	// an invalid height fails before the block is executed
+	require.NotPanics(t, func() {
+		_, err = suite.baseApp.FinalizeBlock(&abci.RequestFinalizeBlock{Height: -1})
+	})
+	require.Error(t, err)
+
+	_, err = suite.baseApp.FinalizeBlock(&abci.RequestFinalizeBlock{Height: 1})
+	require.NoError(t, err)
+	_, err = suite.baseApp.Commit()
+	require.NoError(t, err)
+
+	// halting fails the block while no finalize block state is set
+	require.NotPanics(t, func() {
+		_, err = suite.baseApp.FinalizeBlock(&abci.RequestFinalizeBlock{Height: 2})
+	})
+	require.ErrorContains(t, err, "halt per configuration")
+	require.Contains(t, suite.logBuffer.String(), "skipping ListenFinalizeBlock listening hooks")
+}
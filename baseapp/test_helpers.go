@@ -43,7 +43,7 @@ func (app *BaseApp) SimDeliver(txEncoder sdk.TxEncoder, tx sdk.Tx) (sdk.GasInfo,
 // NewContextLegacy returns a new sdk.Context with the provided header
 func (app *BaseApp) NewContextLegacy(isCheckTx bool, header cmtproto.Header) sdk.Context {
 	if isCheckTx {
-		return sdk.NewContext(app.checkState.ms, true, app.logger).
+		return sdk.NewContext(app.checkState.Load().ms, true, app.logger).
 			WithMinGasPrices(app.minGasPrices).WithBlockHeader(header)
 	}
 
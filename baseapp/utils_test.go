@@ -10,7 +10,10 @@ import (
 	"net/url"
 	"reflect"
 	"strconv"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 	"unsafe"
 
 	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
@@ -109,6 +112,37 @@ func (m CounterServerImpl) IncrementCounter(ctx context.Context, msg *baseapptes
 	return incrementCounter(ctx, m.t, m.capKey, m.deliverKey, msg)
 }
 
+// BlockingCounterServerImpl writes to the store and then blocks until its
+// context is cancelled, reporting the context error on result. It only blocks
+// while block is set.
+type BlockingCounterServerImpl struct {
+	capKey  storetypes.StoreKey
+	key     []byte
+	block   *atomic.Bool
+	started chan struct{}
+	result  chan error
+}
+
+func (m BlockingCounterServerImpl) IncrementCounter(ctx context.Context, msg *baseapptestutil.MsgCounter) (*baseapptestutil.MsgCreateCounterResponse, error) {
+	if !m.block.Load() {
+		return &baseapptestutil.MsgCreateCounterResponse{}, nil
+	}
+
+	sdk.UnwrapSDKContext(ctx).KVStore(m.capKey).Set(m.key, []byte("value"))
+	close(m.started)
+
+	var err error
+	select {
+	case <-ctx.Done():
+		err = ctx.Err()
+	case <-time.After(10 * time.Second):
+		err = errors.New("context was not cancelled")
+	}
+	m.result <- err
+
+	return nil, err
+}
+
 type Counter2ServerImpl struct {
 	t          *testing.T
 	capKey     storetypes.StoreKey
@@ -119,6 +153,52 @@ func (m Counter2ServerImpl) IncrementCounter(ctx context.Context, msg *baseappte
 	return incrementCounter(ctx, m.t, m.capKey, m.deliverKey, msg)
 }
 
+// BlockGasInfoServerImpl consumes gas and records the block gas info of the
+// app when handling each message.
+type BlockGasInfoServerImpl struct {
+	app   *baseapp.BaseApp
+	gas   uint64
+	infos *[]baseapp.BlockGasInfo
+}
+
+func (m BlockGasInfoServerImpl) IncrementCounter(ctx context.Context, msg *baseapptestutil.MsgCounter) (*baseapptestutil.MsgCreateCounterResponse, error) {
+	sdk.UnwrapSDKContext(ctx).GasMeter().ConsumeGas(m.gas, "block gas info")
+	*m.infos = append(*m.infos, *m.app.CurrentBlockGasInfo())
+	return &baseapptestutil.MsgCreateCounterResponse{}, nil
+}
+
+// FundServerImpl credits the MsgCounter counter to a balance kept in the store.
+type FundServerImpl struct {
+	t          *testing.T
+	capKey     storetypes.StoreKey
+	balanceKey []byte
+}
+
+func (m FundServerImpl) IncrementCounter(ctx context.Context, msg *baseapptestutil.MsgCounter) (*baseapptestutil.MsgCreateCounterResponse, error) {
+	store := sdk.UnwrapSDKContext(ctx).KVStore(m.capKey)
+	setIntOnStore(store, m.balanceKey, getIntFromStore(m.t, store, m.balanceKey)+msg.Counter)
+	return &baseapptestutil.MsgCreateCounterResponse{}, nil
+}
+
+// SpendServerImpl debits the MsgCounter2 counter from the balance credited by
+// FundServerImpl, failing when the balance is insufficient.
+type SpendServerImpl struct {
+	t          *testing.T
+	capKey     storetypes.StoreKey
+	balanceKey []byte
+}
+
+func (m SpendServerImpl) IncrementCounter(ctx context.Context, msg *baseapptestutil.MsgCounter2) (*baseapptestutil.MsgCreateCounterResponse, error) {
+	store := sdk.UnwrapSDKContext(ctx).KVStore(m.capKey)
+	balance := getIntFromStore(m.t, store, m.balanceKey)
+	if balance < msg.Counter {
+		return nil, fmt.Errorf("insufficient balance %d to spend %d", balance, msg.Counter)
+	}
+
+	setIntOnStore(store, m.balanceKey, balance-msg.Counter)
+	return &baseapptestutil.MsgCreateCounterResponse{}, nil
+}
+
 func incrementCounter(ctx context.Context,
 	t *testing.T,
 	capKey storetypes.StoreKey,
@@ -205,6 +285,20 @@ func setIntOnStore(store storetypes.KVStore, key []byte, i int64) {
 	store.Set(key, bz[:n])
 }
 
+// commitInfoReadsDB counts the reads of commit info entries ("s/<version>"
+// keys) from the wrapped database.
+type commitInfoReadsDB struct {
+	dbm.DB
+	reads *atomic.Int64
+}
+
+func (db commitInfoReadsDB) Get(key []byte) ([]byte, error) {
+	if bytes.HasPrefix(key, []byte("s/")) {
+		db.reads.Add(1)
+	}
+	return db.DB.Get(key)
+}
+
 type paramStore struct {
 	db *dbm.MemDB
 }
@@ -267,8 +361,9 @@ func testLoadVersionHelper(t *testing.T, app *baseapp.BaseApp, expectedHeight in
 func getCheckStateCtx(app *baseapp.BaseApp) sdk.Context {
 	v := reflect.ValueOf(app).Elem()
 	f := v.FieldByName("checkState")
-	rf := reflect.NewAt(f.Type(), unsafe.Pointer(f.UnsafeAddr())).Elem()
-	return rf.MethodByName("Context").Call(nil)[0].Interface().(sdk.Context)
+	rf := reflect.NewAt(f.Type(), unsafe.Pointer(f.UnsafeAddr()))
+	st := rf.MethodByName("Load").Call(nil)[0]
+	return st.MethodByName("Context").Call(nil)[0].Interface().(sdk.Context)
 }
 
 func getFinalizeBlockStateCtx(app *baseapp.BaseApp) sdk.Context {
@@ -376,3 +471,61 @@ func wonkyMsg(t *testing.T, cfg client.TxConfig, tx signing.Tx) signing.Tx {
 	require.NoError(t, err)
 	return builder.GetTx()
 }
+
+// kvParamStore stores the consensus params in a mounted KV store, so that they
+// are versioned with the rest of the state.
+type kvParamStore struct {
+	key storetypes.StoreKey
+}
+
+var _ baseapp.ParamStore = (*kvParamStore)(nil)
+
+func (ps kvParamStore) Set(ctx context.Context, value cmtproto.ConsensusParams) error {
+	bz, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+
+	sdk.UnwrapSDKContext(ctx).KVStore(ps.key).Set(ParamStoreKey, bz)
+	return nil
+}
+
+func (ps kvParamStore) Has(ctx context.Context) (bool, error) {
+	return sdk.UnwrapSDKContext(ctx).KVStore(ps.key).Has(ParamStoreKey), nil
+}
+
+func (ps kvParamStore) Get(ctx context.Context) (cmtproto.ConsensusParams, error) {
+	bz := sdk.UnwrapSDKContext(ctx).KVStore(ps.key).Get(ParamStoreKey)
+	if len(bz) == 0 {
+		return cmtproto.ConsensusParams{}, errors.New("params not found")
+	}
+
+	var params cmtproto.ConsensusParams
+	if err := json.Unmarshal(bz, &params); err != nil {
+		return cmtproto.ConsensusParams{}, err
+	}
+
+	return params, nil
+}
+
+// fakeClock is a baseapp.Clock whose time only moves when Sleep is called.
+type fakeClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+func newFakeClock(now time.Time) *fakeClock {
+	return &fakeClock{now: now}
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *fakeClock) Sleep(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+}
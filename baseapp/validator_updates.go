@@ -0,0 +1,97 @@
+package baseapp
+
+import (
+	abci "github.com/cometbft/cometbft/abci/types"
+	cryptoenc "github.com/cometbft/cometbft/crypto/encoding"
+	cmtbytes "github.com/cometbft/cometbft/libs/bytes"
+)
+
+// ValidatorUpdatesDiff describes how the validator set tracked by BaseApp was
+// changed by the validator updates of a block. It is the JSON encoded response
+// of the /app/validator-updates-diff query.
+type ValidatorUpdatesDiff struct {
+	Height  int64                  `json:"height"`
+	Added   []ValidatorPowerChange `json:"added"`
+	Removed []ValidatorPowerChange `json:"removed"`
+	Updated []ValidatorPowerChange `json:"updated"`
+}
+
+// ValidatorPowerChange is the voting power change of a single validator. Added
+// validators have an OldPower of zero and removed validators a Power of zero.
+type ValidatorPowerChange struct {
+	PubKeyType string            `json:"pub_key_type"`
+	PubKey     cmtbytes.HexBytes `json:"pub_key"`
+	OldPower   int64             `json:"old_power"`
+	Power      int64             `json:"power"`
+}
+
+// seedValidatorSet resets the tracked validator set to the given genesis
+// validators. It is a no-op unless validator updates diffs are enabled.
+func (app *BaseApp) seedValidatorSet(validators []abci.ValidatorUpdate) {
+	if app.validatorUpdatesDiffLimit == 0 {
+		return
+	}
+
+	app.validatorSet = make(map[string]int64, len(validators))
+	app.validatorSetOverflow = false
+	app.lastValidatorUpdatesDiff.Store(nil)
+
+	app.applyValidatorUpdates(0, validators)
+}
+
+// applyValidatorUpdates applies the validator updates of the block at the
+// given height to the tracked validator set and records the resulting diff.
+// Nothing is tracked until the set is seeded by InitChain, e.g. after a
+// restart, as diffs against an unknown set would be wrong. Tracking stops once
+// the set grows past the configured limit.
+func (app *BaseApp) applyValidatorUpdates(height int64, updates []abci.ValidatorUpdate) {
+	if app.validatorUpdatesDiffLimit == 0 || app.validatorSetOverflow || app.validatorSet == nil {
+		return
+	}
+
+	diff := &ValidatorUpdatesDiff{Height: height}
+	for _, update := range updates {
+		key := update.PubKey.String()
+		oldPower, found := app.validatorSet[key]
+
+		change := ValidatorPowerChange{OldPower: oldPower, Power: update.Power}
+		if pk, err := cryptoenc.PubKeyFromProto(update.PubKey); err == nil {
+			change.PubKeyType = pk.Type()
+			change.PubKey = pk.Bytes()
+		}
+
+		switch {
+		case update.Power == 0:
+			if !found {
+				continue
+			}
+
+			delete(app.validatorSet, key)
+			diff.Removed = append(diff.Removed, change)
+
+		case !found:
+			app.validatorSet[key] = update.Power
+			diff.Added = append(diff.Added, change)
+
+		case oldPower != update.Power:
+			app.validatorSet[key] = update.Power
+			diff.Updated = append(diff.Updated, change)
+		}
+	}
+
+	if len(app.validatorSet) > app.validatorUpdatesDiffLimit {
+		app.logger.Error(
+			"validator set exceeds the validator updates diff limit; no longer tracking validator updates",
+			"height", height,
+			"size", len(app.validatorSet),
+			"limit", app.validatorUpdatesDiffLimit,
+		)
+
+		app.validatorSet = nil
+		app.validatorSetOverflow = true
+		app.lastValidatorUpdatesDiff.Store(nil)
+		return
+	}
+
+	app.lastValidatorUpdatesDiff.Store(diff)
+}
@@ -42,6 +42,14 @@ type BaseConfig struct {
 	// If set to 0, it is unbounded.
 	QueryGasLimit uint64 `mapstructure:"query-gas-limit"`
 
+	// The maximum size in bytes of the result of a store range query. Larger
+	// results are truncated. If set to 0, it is unbounded.
+	QueryMaxResponseBytes uint64 `mapstructure:"query-max-response-bytes"`
+
+	// The maximum number of blocks a queried height may be behind the latest
+	// height. If set to 0, it is unbounded.
+	MaxQueryLag uint64 `mapstructure:"max-query-lag"`
+
 	Pruning           string `mapstructure:"pruning"`
 	PruningKeepRecent string `mapstructure:"pruning-keep-recent"`
 	PruningInterval   string `mapstructure:"pruning-interval"`
@@ -219,17 +227,19 @@ func (c *Config) GetMinGasPrices() sdk.DecCoins {
 func DefaultConfig() *Config {
 	return &Config{
 		BaseConfig: BaseConfig{
-			MinGasPrices:        defaultMinGasPrices,
-			QueryGasLimit:       0,
-			InterBlockCache:     true,
-			Pruning:             pruningtypes.PruningOptionDefault,
-			PruningKeepRecent:   "0",
-			PruningInterval:     "0",
-			MinRetainBlocks:     0,
-			IndexEvents:         make([]string, 0),
-			IAVLCacheSize:       781250,
-			IAVLDisableFastNode: false,
-			AppDBBackend:        "",
+			MinGasPrices:          defaultMinGasPrices,
+			QueryGasLimit:         0,
+			QueryMaxResponseBytes: 0,
+			MaxQueryLag:           0,
+			InterBlockCache:       true,
+			Pruning:               pruningtypes.PruningOptionDefault,
+			PruningKeepRecent:     "0",
+			PruningInterval:       "0",
+			MinRetainBlocks:       0,
+			IndexEvents:           make([]string, 0),
+			IAVLCacheSize:         781250,
+			IAVLDisableFastNode:   false,
+			AppDBBackend:          "",
 		},
 		Telemetry: telemetry.Config{
 			Enabled:      false,
@@ -25,6 +25,16 @@ minimum-gas-prices = "{{ .BaseConfig.MinGasPrices }}"
 # If this is set to zero, the query can consume an unbounded amount of gas.
 query-gas-limit = "{{ .BaseConfig.QueryGasLimit }}"
 
+# The maximum size in bytes of the result of a store range (subspace) query.
+# Larger results are truncated and flagged with "truncated":true in the response
+# info. If this is set to zero, the result size is unbounded.
+query-max-response-bytes = "{{ .BaseConfig.QueryMaxResponseBytes }}"
+
+# The maximum number of blocks a queried height may be behind the latest height.
+# Queries for older heights are rejected. If this is set to zero, any retained
+# height can be queried.
+max-query-lag = "{{ .BaseConfig.MaxQueryLag }}"
+
 # default: the last 362880 states are kept, pruning at 10 block intervals
 # nothing: all historic states will be saved, nothing will be deleted (i.e. archiving node)
 # everything: 2 latest states will be kept; pruning at 10 block intervals.
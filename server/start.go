@@ -52,19 +52,21 @@ import (
 
 const (
 	// CometBFT full-node start flags
-	flagWithComet          = "with-comet"
-	flagAddress            = "address"
-	flagTransport          = "transport"
-	flagTraceStore         = "trace-store"
-	flagCPUProfile         = "cpu-profile"
-	FlagMinGasPrices       = "minimum-gas-prices"
-	FlagQueryGasLimit      = "query-gas-limit"
-	FlagHaltHeight         = "halt-height"
-	FlagHaltTime           = "halt-time"
-	FlagInterBlockCache    = "inter-block-cache"
-	FlagUnsafeSkipUpgrades = "unsafe-skip-upgrades"
-	FlagTrace              = "trace"
-	FlagInvCheckPeriod     = "inv-check-period"
+	flagWithComet             = "with-comet"
+	flagAddress               = "address"
+	flagTransport             = "transport"
+	flagTraceStore            = "trace-store"
+	flagCPUProfile            = "cpu-profile"
+	FlagMinGasPrices          = "minimum-gas-prices"
+	FlagQueryGasLimit         = "query-gas-limit"
+	FlagQueryMaxResponseBytes = "query-max-response-bytes"
+	FlagMaxQueryLag           = "max-query-lag"
+	FlagHaltHeight            = "halt-height"
+	FlagHaltTime              = "halt-time"
+	FlagInterBlockCache       = "inter-block-cache"
+	FlagUnsafeSkipUpgrades    = "unsafe-skip-upgrades"
+	FlagTrace                 = "trace"
+	FlagInvCheckPeriod        = "inv-check-period"
 
 	FlagPruning             = "pruning"
 	FlagPruningKeepRecent   = "pruning-keep-recent"
@@ -954,6 +956,8 @@ func addStartNodeFlags[T types.Application](cmd *cobra.Command, opts StartCmdOpt
 	cmd.Flags().String(flagTraceStore, "", "Enable KVStore tracing to an output file")
 	cmd.Flags().String(FlagMinGasPrices, "", "Minimum gas prices to accept for transactions; Any fee in a tx must meet this minimum (e.g. 0.01photino;0.0001stake)")
 	cmd.Flags().Uint64(FlagQueryGasLimit, 0, "Maximum gas a Rest/Grpc query can consume. Blank and 0 imply unbounded.")
+	cmd.Flags().Uint64(FlagQueryMaxResponseBytes, 0, "Maximum size in bytes of a store range query result, larger results are truncated. Blank and 0 imply unbounded.")
+	cmd.Flags().Uint64(FlagMaxQueryLag, 0, "Maximum number of blocks a queried height may be behind the latest height. Blank and 0 imply unbounded.")
 	cmd.Flags().IntSlice(FlagUnsafeSkipUpgrades, []int{}, "Skip a set of upgrade heights to continue the old binary")
 	cmd.Flags().Uint64(FlagHaltHeight, 0, "Block height at which to gracefully halt the chain and shutdown the node")
 	cmd.Flags().Uint64(FlagHaltTime, 0, "Minimum block time (in Unix seconds) at which to gracefully halt the chain and shutdown the node")
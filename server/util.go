@@ -543,6 +543,8 @@ func DefaultBaseappOptions(appOpts types.AppOptions) []func(*baseapp.BaseApp) {
 		defaultMempool,
 		baseapp.SetChainID(chainID),
 		baseapp.SetQueryGasLimit(cast.ToUint64(appOpts.Get(FlagQueryGasLimit))),
+		baseapp.SetQueryMaxResponseBytes(cast.ToUint64(appOpts.Get(FlagQueryMaxResponseBytes))),
+		baseapp.SetMaxQueryLag(cast.ToUint64(appOpts.Get(FlagMaxQueryLag))),
 	}
 }
 
@@ -85,7 +85,7 @@ func (s *SimTestSuite) SetupTest() {
 
 	var (
 		accountKeeper authkeeper.AccountKeeper
-		mintKeeper    mintkeeper.Keeper
+		mintKeeper    *mintkeeper.Keeper
 		bankKeeper    bankkeeper.Keeper
 		distrKeeper   distrkeeper.Keeper
 		stakingKeeper *stakingkeeper.Keeper
@@ -56,7 +56,7 @@ type SimTestSuite struct {
 	stakingKeeper     *stakingkeeper.Keeper
 	slashingKeeper    slashingkeeper.Keeper
 	distrKeeper       distributionkeeper.Keeper
-	mintKeeper        mintkeeper.Keeper
+	mintKeeper        *mintkeeper.Keeper
 }
 
 func (suite *SimTestSuite) SetupTest() {
@@ -140,6 +140,18 @@ var (
 	// supplied.
 	ErrInvalidGasLimit = errorsmod.Register(RootCodespace, 41, "invalid gas limit")
 
+	// ErrTimeout defines an error when an operation did not complete before its
+	// deadline.
+	ErrTimeout = errorsmod.Register(RootCodespace, 42, "timeout")
+
+	// ErrResourceExhausted defines an error when a resource limit, e.g. a rate
+	// limit or a quota, was reached.
+	ErrResourceExhausted = errorsmod.Register(RootCodespace, 43, "resource exhausted")
+
+	// ErrUnavailable defines an error when a service is temporarily unavailable
+	// and the request may be retried.
+	ErrUnavailable = errorsmod.Register(RootCodespace, 44, "service unavailable")
+
 	// ErrPanic should only be set when we recovering from a panic
 	ErrPanic = errorsmod.ErrPanic
 )
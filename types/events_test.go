@@ -2,6 +2,7 @@ package types_test
 
 import (
 	"encoding/json"
+	"fmt"
 	"reflect"
 	"testing"
 
@@ -274,3 +275,44 @@ func (s *eventsTestSuite) TestMarkEventsToIndex() {
 		})
 	}
 }
+
+func (s *eventsTestSuite) TestMarkEventsToIndexDeterministic() {
+	// attributes are deliberately not sorted by key, and half of them are
+	// looked up in the index set
+	attrs := make([]abci.EventAttribute, 100)
+	indexSet := map[string]struct{}{}
+	for i := range attrs {
+		key := fmt.Sprintf("key%d", (i*37)%len(attrs))
+		attrs[i] = abci.EventAttribute{Key: key, Value: fmt.Sprintf("value%d", i)}
+		if i%2 == 0 {
+			indexSet["transfer."+key] = struct{}{}
+		}
+	}
+	events := []abci.Event{{Type: "transfer", Attributes: attrs}, {Type: "message", Attributes: attrs}}
+
+	encode := func(events []abci.Event) []byte {
+		var bz []byte
+		for _, e := range events {
+			ebz, err := e.Marshal()
+			s.Require().NoError(err)
+			bz = append(bz, ebz...)
+		}
+		return bz
+	}
+
+	marked := sdk.MarkEventsToIndex(events, indexSet)
+	expected := encode(marked)
+	for i := 0; i < 50; i++ {
+		s.Require().Equal(expected, encode(sdk.MarkEventsToIndex(events, indexSet)))
+	}
+
+	// the attributes keep their original order
+	for i, e := range marked {
+		s.Require().Len(e.Attributes, len(attrs))
+		for j, attr := range e.Attributes {
+			s.Require().Equal(attrs[j].Key, attr.Key)
+			s.Require().Equal(attrs[j].Value, attr.Value)
+			s.Require().Equal(i == 0 && j%2 == 0, attr.Index)
+		}
+	}
+}
@@ -24,6 +24,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"runtime/debug"
 	"sort"
 
 	abci "github.com/cometbft/cometbft/abci/types"
@@ -143,6 +144,10 @@ type Manager struct {
 	OrderPrepareCheckStaters []string
 	OrderPrecommiters        []string
 	OrderMigrations          []string
+
+	// recoverBlockerPanics holds the modules whose begin and end blocker
+	// panics are recovered instead of failing the block.
+	recoverBlockerPanics map[string]bool
 }
 
 // NewManager creates a new Manager object.
@@ -305,6 +310,22 @@ func (m *Manager) SetOrderMigrations(moduleNames ...string) {
 	m.OrderMigrations = moduleNames
 }
 
+// SetRecoverBlockerPanics sets the modules whose BeginBlock and EndBlock panics
+// are recovered. The blockers of these modules run on a cached context: if one
+// panics, its state changes and events are discarded, the panic is logged and
+// the remaining modules keep running. By default no panic is recovered and a
+// panicking module fails the whole block.
+func (m *Manager) SetRecoverBlockerPanics(moduleNames ...string) {
+	recoverPanics := make(map[string]bool, len(moduleNames))
+	for _, moduleName := range moduleNames {
+		if _, ok := m.Modules[moduleName]; !ok {
+			panic(fmt.Sprintf("unknown module %s in SetRecoverBlockerPanics", moduleName))
+		}
+		recoverPanics[moduleName] = true
+	}
+	m.recoverBlockerPanics = recoverPanics
+}
+
 // RegisterLegacyAminoCodec registers all module codecs
 func (m *Manager) RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	for _, b := range m.Modules {
@@ -725,7 +746,10 @@ func (m *Manager) BeginBlock(ctx sdk.Context) (sdk.BeginBlock, error) {
 	ctx = ctx.WithEventManager(sdk.NewEventManager())
 	for _, moduleName := range m.OrderBeginBlockers {
 		if module, ok := m.Modules[moduleName].(appmodule.HasBeginBlocker); ok {
-			if err := module.BeginBlock(ctx); err != nil {
+			err := m.runBlocker(ctx, moduleName, "BeginBlock", func(ctx sdk.Context) error {
+				return module.BeginBlock(ctx)
+			})
+			if err != nil {
 				return sdk.BeginBlock{}, err
 			}
 		}
@@ -745,12 +769,18 @@ func (m *Manager) EndBlock(ctx sdk.Context) (sdk.EndBlock, error) {
 
 	for _, moduleName := range m.OrderEndBlockers {
 		if module, ok := m.Modules[moduleName].(appmodule.HasEndBlocker); ok {
-			err := module.EndBlock(ctx)
+			err := m.runBlocker(ctx, moduleName, "EndBlock", func(ctx sdk.Context) error {
+				return module.EndBlock(ctx)
+			})
 			if err != nil {
 				return sdk.EndBlock{}, err
 			}
 		} else if module, ok := m.Modules[moduleName].(HasABCIEndBlock); ok {
-			moduleValUpdates, err := module.EndBlock(ctx)
+			var moduleValUpdates []abci.ValidatorUpdate
+			err := m.runBlocker(ctx, moduleName, "EndBlock", func(ctx sdk.Context) (err error) {
+				moduleValUpdates, err = module.EndBlock(ctx)
+				return err
+			})
 			if err != nil {
 				return sdk.EndBlock{}, err
 			}
@@ -772,6 +802,36 @@ func (m *Manager) EndBlock(ctx sdk.Context) (sdk.EndBlock, error) {
 	}, nil
 }
 
+// runBlocker runs the begin or end blocker fn of the given module. If panic
+// recovery is enabled for the module, fn runs on a cached context which is only
+// written if fn succeeds, and a panic is logged and swallowed.
+func (m *Manager) runBlocker(ctx sdk.Context, moduleName, blocker string, fn func(sdk.Context) error) (err error) {
+	if !m.recoverBlockerPanics[moduleName] {
+		return fn(ctx)
+	}
+
+	cacheCtx, writeCache := ctx.CacheContext()
+	defer func() {
+		if r := recover(); r != nil {
+			ctx.Logger().Error(
+				"recovered panic in module blocker; skipping module",
+				"module", moduleName,
+				"blocker", blocker,
+				"panic", r,
+				"stack", string(debug.Stack()),
+			)
+			err = nil
+		}
+	}()
+
+	if err := fn(cacheCtx); err != nil {
+		return err
+	}
+
+	writeCache()
+	return nil
+}
+
 // Precommit performs precommit functionality for all modules.
 func (m *Manager) Precommit(ctx sdk.Context) error {
 	for _, moduleName := range m.OrderPrecommiters {
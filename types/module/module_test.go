@@ -15,10 +15,12 @@ import (
 
 	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
 	authtypes "cosmossdk.io/x/auth/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
 	"github.com/cosmos/cosmos-sdk/testutil/mock"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
@@ -467,6 +469,44 @@ func TestCoreAPIManager_BeginBlock(t *testing.T) {
 	require.EqualError(t, err, "some error")
 }
 
+func TestCoreAPIManager_BeginBlock_RecoverPanics(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	t.Cleanup(mockCtrl.Finish)
+
+	key := storetypes.NewKVStoreKey("test")
+	ctx := testutil.DefaultContext(key, storetypes.NewTransientStoreKey("transient_test"))
+
+	mockAppModule1 := mock.NewMockCoreAppModule(mockCtrl)
+	mockAppModule2 := mock.NewMockCoreAppModule(mockCtrl)
+	mm := module.NewManagerFromMap(map[string]appmodule.AppModule{
+		"module1": mockAppModule1,
+		"module2": mockAppModule2,
+	})
+	panicking := func(ctx context.Context) error {
+		sdk.UnwrapSDKContext(ctx).KVStore(key).Set([]byte("key"), []byte("value"))
+		panic("module1 panic")
+	}
+
+	// strict mode: the panic fails the block
+	mockAppModule1.EXPECT().BeginBlock(gomock.Any()).Times(1).DoAndReturn(panicking)
+	require.PanicsWithValue(t, "module1 panic", func() {
+		_, _ = mm.BeginBlock(ctx)
+	})
+
+	// recovery mode: the panicking module is skipped and its writes discarded
+	ctx = testutil.DefaultContext(key, storetypes.NewTransientStoreKey("transient_test"))
+	mm.SetRecoverBlockerPanics("module1")
+	mockAppModule1.EXPECT().BeginBlock(gomock.Any()).Times(1).DoAndReturn(panicking)
+	mockAppModule2.EXPECT().BeginBlock(gomock.Any()).Times(1).Return(nil)
+	_, err := mm.BeginBlock(ctx)
+	require.NoError(t, err)
+	require.Nil(t, ctx.KVStore(key).Get([]byte("key")))
+
+	require.PanicsWithValue(t, "unknown module module3 in SetRecoverBlockerPanics", func() {
+		mm.SetRecoverBlockerPanics("module3")
+	})
+}
+
 func TestCoreAPIManager_EndBlock(t *testing.T) {
 	mockCtrl := gomock.NewController(t)
 	t.Cleanup(mockCtrl.Finish)
@@ -90,6 +90,7 @@ func (k Keeper) Grants(ctx context.Context, req *authz.QueryGrantsRequest) (*aut
 }
 
 // GranterGrants implements the Query/GranterGrants gRPC method.
+// If msg type URL is set, it returns only the grants for that msg type.
 func (k Keeper) GranterGrants(ctx context.Context, req *authz.QueryGranterGrantsRequest) (*authz.QueryGranterGrantsResponse, error) {
 	if req == nil {
 		return nil, status.Errorf(codes.InvalidArgument, "empty request")
@@ -109,6 +110,10 @@ func (k Keeper) GranterGrants(ctx context.Context, req *authz.QueryGranterGrants
 			return nil, err
 		}
 
+		if req.MsgTypeUrl != "" && auth1.MsgTypeURL() != req.MsgTypeUrl {
+			return nil, nil
+		}
+
 		any, err := codectypes.NewAnyWithValue(auth1)
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, err.Error())
@@ -141,6 +146,7 @@ func (k Keeper) GranterGrants(ctx context.Context, req *authz.QueryGranterGrants
 }
 
 // GranteeGrants implements the Query/GranteeGrants gRPC method.
+// If msg type URL is set, it returns only the grants for that msg type.
 func (k Keeper) GranteeGrants(ctx context.Context, req *authz.QueryGranteeGrantsRequest) (*authz.QueryGranteeGrantsResponse, error) {
 	if req == nil {
 		return nil, status.Errorf(codes.InvalidArgument, "empty request")
@@ -159,11 +165,15 @@ func (k Keeper) GranteeGrants(ctx context.Context, req *authz.QueryGranteeGrants
 			return nil, err
 		}
 
-		granter, g, _ := parseGrantStoreKey(append(GrantKey, key...))
+		granter, g, msgType := parseGrantStoreKey(append(GrantKey, key...))
 		if !bytes.Equal(g, grantee) {
 			return nil, nil
 		}
 
+		if req.MsgTypeUrl != "" && msgType != req.MsgTypeUrl {
+			return nil, nil
+		}
+
 		authorizationAny, err := codectypes.NewAnyWithValue(auth1)
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, err.Error())
@@ -192,3 +202,41 @@ func (k Keeper) GranteeGrants(ctx context.Context, req *authz.QueryGranteeGrants
 		Pagination: pageRes,
 	}, nil
 }
+
+// GranteeGrantsStream implements the Query/GranteeGrantsStream gRPC method.
+// It sends the grants of the grantee one page at a time, following the next
+// key of each page until all the grants have been sent.
+func (k Keeper) GranteeGrantsStream(req *authz.QueryGranteeGrantsRequest, stream authz.Query_GranteeGrantsStreamServer) error {
+	if req == nil {
+		return status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	pageReq := req.Pagination
+	for first := true; ; first = false {
+		res, err := k.GranteeGrants(stream.Context(), &authz.QueryGranteeGrantsRequest{
+			Grantee:    req.Grantee,
+			Pagination: pageReq,
+			MsgTypeUrl: req.MsgTypeUrl,
+		})
+		if err != nil {
+			return err
+		}
+
+		// the last page may be empty if the previous one was exactly full
+		if first || len(res.Grants) > 0 {
+			if err := stream.Send(res); err != nil {
+				return err
+			}
+		}
+
+		if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+			return nil
+		}
+
+		pageReq = &query.PageRequest{
+			Key:     res.Pagination.NextKey,
+			Limit:   pageReq.GetLimit(),
+			Reverse: pageReq.GetReverse(),
+		}
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
 
 	"cosmossdk.io/x/authz"
 	banktypes "cosmossdk.io/x/bank/types"
@@ -181,6 +182,52 @@ func (suite *TestSuite) TestGRPCQueryGranterGrants() {
 			},
 			1,
 		},
+		{
+			"valid case, filter by msg type url",
+			func() {
+				suite.createMultiSendAuthorization(addrs[3], addrs[0])
+			},
+			false,
+			authz.QueryGranterGrantsRequest{
+				Granter:    addrs[0].String(),
+				MsgTypeUrl: banktypes.SendAuthorization{}.MsgTypeURL(),
+			},
+			2,
+		},
+		{
+			"valid case, filter by other msg type url",
+			func() {},
+			false,
+			authz.QueryGranterGrantsRequest{
+				Granter:    addrs[0].String(),
+				MsgTypeUrl: sdk.MsgTypeURL(&banktypes.MsgMultiSend{}),
+			},
+			1,
+		},
+		{
+			"valid case, filter by non matching msg type url",
+			func() {},
+			false,
+			authz.QueryGranterGrantsRequest{
+				Granter:    addrs[0].String(),
+				MsgTypeUrl: sdk.MsgTypeURL(&banktypes.MsgUpdateParams{}),
+			},
+			0,
+		},
+		{
+			"valid case, filter by msg type url with pagination",
+			func() {},
+			false,
+			authz.QueryGranterGrantsRequest{
+				Granter:    addrs[0].String(),
+				MsgTypeUrl: banktypes.SendAuthorization{}.MsgTypeURL(),
+				Pagination: &query.PageRequest{
+					Limit:      1,
+					CountTotal: true,
+				},
+			},
+			1,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -259,6 +306,52 @@ func (suite *TestSuite) TestGRPCQueryGranteeGrants() {
 			},
 			1,
 		},
+		{
+			"valid case, filter by msg type url",
+			func() {
+				suite.createMultiSendAuthorization(addrs[0], addrs[3])
+			},
+			false,
+			authz.QueryGranteeGrantsRequest{
+				Grantee:    addrs[0].String(),
+				MsgTypeUrl: banktypes.SendAuthorization{}.MsgTypeURL(),
+			},
+			2,
+		},
+		{
+			"valid case, filter by other msg type url",
+			func() {},
+			false,
+			authz.QueryGranteeGrantsRequest{
+				Grantee:    addrs[0].String(),
+				MsgTypeUrl: sdk.MsgTypeURL(&banktypes.MsgMultiSend{}),
+			},
+			1,
+		},
+		{
+			"valid case, filter by non matching msg type url",
+			func() {},
+			false,
+			authz.QueryGranteeGrantsRequest{
+				Grantee:    addrs[0].String(),
+				MsgTypeUrl: sdk.MsgTypeURL(&banktypes.MsgUpdateParams{}),
+			},
+			0,
+		},
+		{
+			"valid case, filter by msg type url with pagination",
+			func() {},
+			false,
+			authz.QueryGranteeGrantsRequest{
+				Grantee:    addrs[0].String(),
+				MsgTypeUrl: banktypes.SendAuthorization{}.MsgTypeURL(),
+				Pagination: &query.PageRequest{
+					Limit:      1,
+					CountTotal: true,
+				},
+			},
+			1,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -277,6 +370,66 @@ func (suite *TestSuite) TestGRPCQueryGranteeGrants() {
 	}
 }
 
+// granteeGrantsStream collects the responses sent on a GranteeGrantsStream.
+type granteeGrantsStream struct {
+	grpc.ServerStream
+	ctx       gocontext.Context
+	responses []*authz.QueryGranteeGrantsResponse
+}
+
+func (s *granteeGrantsStream) Context() gocontext.Context { return s.ctx }
+
+func (s *granteeGrantsStream) Send(res *authz.QueryGranteeGrantsResponse) error {
+	s.responses = append(s.responses, res)
+	return nil
+}
+
+func (suite *TestSuite) TestGRPCQueryGranteeGrantsStream() {
+	require := suite.Require()
+	addrs := suite.addrs
+
+	for _, granter := range addrs[1:] {
+		suite.createSendAuthorization(addrs[0], granter)
+	}
+	numGrants := len(addrs) - 1
+
+	testCases := []struct {
+		msg       string
+		limit     uint64
+		numChunks int
+	}{
+		{"single chunk", 0, 1},
+		{"multiple chunks", 2, (numGrants + 1) / 2},
+		{"one grant per chunk", 1, numGrants},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			stream := &granteeGrantsStream{ctx: suite.ctx}
+			err := suite.authzKeeper.GranteeGrantsStream(&authz.QueryGranteeGrantsRequest{
+				Grantee:    addrs[0].String(),
+				Pagination: &query.PageRequest{Limit: tc.limit},
+			}, stream)
+			require.NoError(err)
+			require.Len(stream.responses, tc.numChunks)
+
+			granters := make(map[string]bool)
+			for _, res := range stream.responses {
+				for _, grant := range res.Grants {
+					require.Equal(addrs[0].String(), grant.Grantee)
+					require.False(granters[grant.Granter], "grant sent twice")
+					granters[grant.Granter] = true
+				}
+			}
+			require.Len(granters, numGrants)
+		})
+	}
+
+	err := suite.authzKeeper.GranteeGrantsStream(&authz.QueryGranteeGrantsRequest{}, &granteeGrantsStream{ctx: suite.ctx})
+	require.Error(err)
+}
+
 func (suite *TestSuite) createSendAuthorization(grantee, granter sdk.AccAddress) authz.Authorization {
 	exp := suite.ctx.HeaderInfo().Time.Add(time.Hour)
 	newCoins := sdk.NewCoins(sdk.NewInt64Coin("steak", 100))
@@ -286,6 +439,14 @@ func (suite *TestSuite) createSendAuthorization(grantee, granter sdk.AccAddress)
 	return authorization
 }
 
+func (suite *TestSuite) createMultiSendAuthorization(grantee, granter sdk.AccAddress) authz.Authorization {
+	exp := suite.ctx.HeaderInfo().Time.Add(time.Hour)
+	authorization := authz.NewGenericAuthorization(sdk.MsgTypeURL(&banktypes.MsgMultiSend{}))
+	err := suite.authzKeeper.SaveGrant(suite.ctx, grantee, granter, authorization, &exp)
+	suite.Require().NoError(err)
+	return authorization
+}
+
 func (suite *TestSuite) createSendAuthorizationWithAllowList(grantee, granter sdk.AccAddress) authz.Authorization {
 	exp := suite.ctx.HeaderInfo().Time.Add(time.Hour)
 	newCoins := sdk.NewCoins(sdk.NewInt64Coin("steak", 100))
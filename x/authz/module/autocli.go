@@ -44,6 +44,10 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "grantee"},
 					},
 				},
+				{
+					RpcMethod: "GranteeGrantsStream",
+					Skip:      true, // server-streaming queries are not supported by autocli
+				},
 			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
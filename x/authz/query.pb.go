@@ -161,6 +161,8 @@ type QueryGranterGrantsRequest struct {
 	Granter string `protobuf:"bytes,1,opt,name=granter,proto3" json:"granter,omitempty"`
 	// pagination defines an pagination for the request.
 	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// Optional, msg_type_url, when set, will query only grants matching given msg type.
+	MsgTypeUrl string `protobuf:"bytes,3,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
 }
 
 func (m *QueryGranterGrantsRequest) Reset()         { *m = QueryGranterGrantsRequest{} }
@@ -210,6 +212,13 @@ func (m *QueryGranterGrantsRequest) GetPagination() *query.PageRequest {
 	return nil
 }
 
+func (m *QueryGranterGrantsRequest) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
 // QueryGranterGrantsResponse is the response type for the Query/GranterGrants RPC method.
 type QueryGranterGrantsResponse struct {
 	// grants is a list of grants granted by the granter.
@@ -270,6 +279,8 @@ type QueryGranteeGrantsRequest struct {
 	Grantee string `protobuf:"bytes,1,opt,name=grantee,proto3" json:"grantee,omitempty"`
 	// pagination defines an pagination for the request.
 	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// Optional, msg_type_url, when set, will query only grants matching given msg type.
+	MsgTypeUrl string `protobuf:"bytes,3,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
 }
 
 func (m *QueryGranteeGrantsRequest) Reset()         { *m = QueryGranteeGrantsRequest{} }
@@ -319,6 +330,13 @@ func (m *QueryGranteeGrantsRequest) GetPagination() *query.PageRequest {
 	return nil
 }
 
+func (m *QueryGranteeGrantsRequest) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
 // QueryGranteeGrantsResponse is the response type for the Query/GranteeGrants RPC method.
 type QueryGranteeGrantsResponse struct {
 	// grants is a list of grants granted to the grantee.
@@ -386,40 +404,41 @@ func init() {
 func init() { proto.RegisterFile("cosmos/authz/v1beta1/query.proto", fileDescriptor_376d714ffdeb1545) }
 
 var fileDescriptor_376d714ffdeb1545 = []byte{
-	// 516 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x95, 0xc1, 0x6e, 0xd3, 0x30,
-	0x18, 0xc7, 0xeb, 0x16, 0x8a, 0xf0, 0xe0, 0x62, 0x38, 0x64, 0x61, 0x8a, 0xa2, 0x0a, 0x41, 0x41,
-	0xc2, 0xde, 0x3a, 0x89, 0x33, 0xdb, 0x61, 0xbb, 0x42, 0x80, 0x0b, 0x97, 0xca, 0xa5, 0x9f, 0xb2,
-	0x88, 0x36, 0xce, 0x6c, 0x07, 0xd1, 0xa1, 0x5d, 0xe0, 0x05, 0x90, 0x76, 0xe0, 0x11, 0x90, 0x38,
-	0xf3, 0x10, 0x1c, 0x27, 0xb8, 0x70, 0x44, 0x2d, 0x82, 0xd7, 0x40, 0xb5, 0x5d, 0xba, 0x8c, 0x6c,
-	0x0b, 0x4c, 0x93, 0x76, 0x8b, 0xa3, 0xff, 0xf7, 0xff, 0x7e, 0xdf, 0xdf, 0xb1, 0x83, 0xc3, 0xe7,
-	0x42, 0x0d, 0x85, 0x62, 0x3c, 0xd7, 0x5b, 0x3b, 0xec, 0xe5, 0x4a, 0x0f, 0x34, 0x5f, 0x61, 0xdb,
-	0x39, 0xc8, 0x11, 0xcd, 0xa4, 0xd0, 0x82, 0x5c, 0xb7, 0x0a, 0x6a, 0x14, 0xd4, 0x29, 0xfc, 0xa5,
-	0x58, 0x88, 0x78, 0x00, 0x8c, 0x67, 0x09, 0xe3, 0x69, 0x2a, 0x34, 0xd7, 0x89, 0x48, 0x95, 0xad,
-	0xf1, 0xef, 0x3a, 0xd7, 0x1e, 0x57, 0x60, 0xcd, 0xfe, 0x58, 0x67, 0x3c, 0x4e, 0x52, 0x23, 0x76,
-	0xda, 0x72, 0x02, 0xdb, 0xcd, 0x2a, 0x16, 0xad, 0xa2, 0x6b, 0x56, 0xcc, 0xe1, 0x98, 0x45, 0xeb,
-	0x27, 0xc2, 0xe4, 0xd1, 0xd4, 0x7f, 0x53, 0xf2, 0x54, 0xab, 0x08, 0xb6, 0x73, 0x50, 0x9a, 0x74,
-	0xf0, 0xa5, 0x78, 0xfa, 0x02, 0xa4, 0x87, 0x42, 0xd4, 0xbe, 0xbc, 0xee, 0x7d, 0xf9, 0x74, 0x6f,
-	0x36, 0xc8, 0x5a, 0xbf, 0x2f, 0x41, 0xa9, 0xc7, 0x5a, 0x26, 0x69, 0x1c, 0xcd, 0x84, 0xf3, 0x1a,
-	0xf0, 0xea, 0xd5, 0x6a, 0x80, 0x84, 0xf8, 0xca, 0x50, 0xc5, 0x5d, 0x3d, 0xca, 0xa0, 0x9b, 0xcb,
-	0x81, 0xd7, 0x98, 0x16, 0x46, 0x78, 0xa8, 0xe2, 0x27, 0xa3, 0x0c, 0x9e, 0xca, 0x01, 0xd9, 0xc0,
-	0x78, 0x3e, 0xb1, 0x77, 0x21, 0x44, 0xed, 0x85, 0xce, 0x2d, 0xea, 0x5c, 0xa7, 0xf1, 0x50, 0x9b,
-	0xb5, 0x9b, 0x9b, 0x3e, 0xe4, 0x31, 0xb8, 0x29, 0xa2, 0x03, 0x95, 0xad, 0x3d, 0x84, 0xaf, 0x15,
-	0x06, 0x55, 0x99, 0x48, 0x15, 0x90, 0x55, 0xdc, 0x34, 0x30, 0xca, 0x43, 0x61, 0xa3, 0xbd, 0xd0,
-	0xb9, 0x41, 0xcb, 0xb6, 0x8b, 0x9a, 0xaa, 0xc8, 0x49, 0xc9, 0x66, 0x01, 0xaa, 0x6e, 0xa0, 0x6e,
-	0x9f, 0x08, 0x65, 0x3b, 0x16, 0xa8, 0xde, 0x23, 0xbc, 0x38, 0xa7, 0x02, 0x79, 0xfa, 0x5d, 0xd8,
-	0x28, 0x41, 0xfb, 0x9f, 0xbc, 0x3e, 0x20, 0xec, 0x97, 0x91, 0xb9, 0xd8, 0x1e, 0x1c, 0x8a, 0xad,
-	0x7d, 0x4c, 0x6c, 0x6b, 0xb9, 0xde, 0x12, 0x32, 0xd9, 0x31, 0xc6, 0x67, 0x9e, 0x21, 0x1c, 0x91,
-	0x21, 0x54, 0xcd, 0x10, 0xce, 0x2a, 0x43, 0x38, 0xb7, 0x19, 0x76, 0x7e, 0x35, 0xf0, 0x45, 0x43,
-	0x4a, 0xde, 0x22, 0xdc, 0xb4, 0x9c, 0xe4, 0x08, 0x9e, 0xbf, 0xaf, 0x0b, 0xff, 0x4e, 0x05, 0xa5,
-	0xed, 0xda, 0xba, 0xf9, 0xe6, 0xeb, 0x8f, 0xbd, 0x7a, 0x40, 0x96, 0x58, 0xe9, 0xb5, 0xe5, 0x06,
-	0xfb, 0x88, 0xf0, 0xd5, 0xc2, 0x87, 0x47, 0xd8, 0x49, 0x2d, 0x0e, 0x1d, 0x1e, 0x7f, 0xb9, 0x7a,
-	0x81, 0x43, 0xbb, 0x6f, 0xd0, 0x96, 0x09, 0x3d, 0x0e, 0x8d, 0xb9, 0x83, 0xc6, 0x5e, 0xbb, 0x87,
-	0xdd, 0x03, 0xb0, 0x50, 0x19, 0x16, 0xfe, 0x15, 0x16, 0x4e, 0x01, 0x0b, 0x33, 0x58, 0xd8, 0x5d,
-	0xa7, 0x9f, 0xc7, 0x01, 0xda, 0x1f, 0x07, 0xe8, 0xfb, 0x38, 0x40, 0xef, 0x26, 0x41, 0x6d, 0x7f,
-	0x12, 0xd4, 0xbe, 0x4d, 0x82, 0xda, 0x33, 0x77, 0x28, 0x54, 0xff, 0x05, 0x4d, 0x04, 0x7b, 0x65,
-	0x0d, 0x7b, 0x4d, 0xf3, 0x9f, 0x58, 0xfd, 0x1d, 0x00, 0x00, 0xff, 0xff, 0x53, 0xa5, 0xac, 0xfc,
-	0xe8, 0x06, 0x00, 0x00,
+	// 531 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x95, 0x4f, 0x6f, 0xd3, 0x30,
+	0x18, 0xc6, 0xe7, 0x6e, 0x14, 0xe1, 0xc1, 0xc5, 0xe3, 0x90, 0x95, 0x29, 0x8a, 0x2a, 0x04, 0x05,
+	0x09, 0xbb, 0xeb, 0x24, 0xce, 0x6c, 0x87, 0xed, 0x0a, 0x19, 0x5c, 0xb8, 0x54, 0x2e, 0x7d, 0x95,
+	0x45, 0xb4, 0x71, 0x66, 0x3b, 0x88, 0x16, 0xed, 0x02, 0x5f, 0x00, 0x69, 0x1f, 0x02, 0x89, 0x33,
+	0x17, 0xbe, 0x01, 0xc7, 0x09, 0x2e, 0x1c, 0x51, 0x8b, 0xf8, 0x1c, 0xa8, 0xb6, 0x4b, 0x97, 0x91,
+	0xad, 0x81, 0x89, 0x3f, 0xb7, 0xba, 0x79, 0xde, 0xf7, 0xfd, 0x3d, 0x8f, 0x63, 0x07, 0x07, 0x4f,
+	0x84, 0xea, 0x0b, 0xc5, 0x78, 0xa6, 0xf7, 0x86, 0xec, 0xd9, 0x7a, 0x07, 0x34, 0x5f, 0x67, 0xfb,
+	0x19, 0xc8, 0x01, 0x4d, 0xa5, 0xd0, 0x82, 0x5c, 0xb5, 0x0a, 0x6a, 0x14, 0xd4, 0x29, 0x6a, 0x6b,
+	0x91, 0x10, 0x51, 0x0f, 0x18, 0x4f, 0x63, 0xc6, 0x93, 0x44, 0x68, 0xae, 0x63, 0x91, 0x28, 0x5b,
+	0x53, 0xbb, 0xed, 0xba, 0x76, 0xb8, 0x02, 0xdb, 0xec, 0x47, 0xeb, 0x94, 0x47, 0x71, 0x62, 0xc4,
+	0x4e, 0x5b, 0x4c, 0x60, 0xa7, 0x59, 0xc5, 0xaa, 0x55, 0xb4, 0xcd, 0x8a, 0xd9, 0x85, 0x7d, 0x54,
+	0xff, 0x86, 0x30, 0x79, 0x30, 0xe9, 0xbf, 0x23, 0x79, 0xa2, 0x55, 0x08, 0xfb, 0x19, 0x28, 0x4d,
+	0x5a, 0xf8, 0x62, 0x34, 0xf9, 0x03, 0xa4, 0x87, 0x02, 0xd4, 0xb8, 0xb4, 0xe5, 0x7d, 0x7c, 0x77,
+	0x67, 0x6a, 0x64, 0xb3, 0xdb, 0x95, 0xa0, 0xd4, 0xae, 0x96, 0x71, 0x12, 0x85, 0x53, 0xe1, 0xac,
+	0x06, 0xbc, 0x4a, 0xb9, 0x1a, 0x20, 0x01, 0xbe, 0xdc, 0x57, 0x51, 0x5b, 0x0f, 0x52, 0x68, 0x67,
+	0xb2, 0xe7, 0x2d, 0x4e, 0x0a, 0x43, 0xdc, 0x57, 0xd1, 0xc3, 0x41, 0x0a, 0x8f, 0x64, 0x8f, 0x6c,
+	0x63, 0x3c, 0x73, 0xec, 0x2d, 0x05, 0xa8, 0xb1, 0xdc, 0xba, 0x41, 0x5d, 0xd7, 0x49, 0x3c, 0xd4,
+	0x66, 0xed, 0x7c, 0xd3, 0xfb, 0x3c, 0x02, 0xe7, 0x22, 0x3c, 0x56, 0x59, 0x3f, 0x44, 0x78, 0x25,
+	0x67, 0x54, 0xa5, 0x22, 0x51, 0x40, 0x36, 0x70, 0xd5, 0xc0, 0x28, 0x0f, 0x05, 0x8b, 0x8d, 0xe5,
+	0xd6, 0x35, 0x5a, 0xb4, 0x5d, 0xd4, 0x54, 0x85, 0x4e, 0x4a, 0x76, 0x72, 0x50, 0x15, 0x03, 0x75,
+	0x73, 0x2e, 0x94, 0x9d, 0x98, 0xa3, 0x7a, 0x8f, 0xf0, 0xea, 0x8c, 0x0a, 0xe4, 0xf9, 0x77, 0x61,
+	0xbb, 0x00, 0xed, 0x37, 0xf2, 0x9a, 0xbf, 0x33, 0xf5, 0x37, 0x08, 0xd7, 0x8a, 0xd8, 0x5d, 0xb0,
+	0xf7, 0x4e, 0x04, 0xdb, 0x38, 0x23, 0xd8, 0xcd, 0x4c, 0xef, 0x09, 0x19, 0x0f, 0xcd, 0xe8, 0x3f,
+	0x9e, 0x32, 0x9c, 0x92, 0x32, 0x94, 0x4d, 0x19, 0xfe, 0x5d, 0xca, 0xf0, 0xdf, 0xa6, 0xdc, 0x1a,
+	0x2f, 0xe1, 0x0b, 0x86, 0x94, 0xbc, 0x42, 0xb8, 0x6a, 0x39, 0xc9, 0x29, 0x3c, 0x3f, 0x5f, 0x39,
+	0xb5, 0x5b, 0x25, 0x94, 0x76, 0x6a, 0xfd, 0xfa, 0xcb, 0x4f, 0x5f, 0x0f, 0x2b, 0x3e, 0x59, 0x63,
+	0x85, 0x57, 0x9f, 0x33, 0xf6, 0x16, 0xe1, 0x2b, 0xb9, 0x57, 0x93, 0xb0, 0x79, 0x23, 0x4e, 0x1c,
+	0xc0, 0x5a, 0xb3, 0x7c, 0x81, 0x43, 0xbb, 0x6b, 0xd0, 0x9a, 0x84, 0x9e, 0x85, 0xc6, 0xdc, 0x61,
+	0x65, 0x2f, 0xdc, 0x8f, 0x83, 0x63, 0xb0, 0x50, 0x1a, 0x16, 0x7e, 0x15, 0x16, 0xce, 0x01, 0x0b,
+	0x53, 0x58, 0x38, 0x20, 0x43, 0xbc, 0x92, 0x6b, 0xb8, 0xab, 0x25, 0xf0, 0xfe, 0x5f, 0x20, 0x6e,
+	0xa2, 0x2d, 0xfa, 0x61, 0xe4, 0xa3, 0xa3, 0x91, 0x8f, 0xbe, 0x8c, 0x7c, 0xf4, 0x7a, 0xec, 0x2f,
+	0x1c, 0x8d, 0xfd, 0x85, 0xcf, 0x63, 0x7f, 0xe1, 0xb1, 0x3b, 0xb2, 0xaa, 0xfb, 0x94, 0xc6, 0x82,
+	0x3d, 0xb7, 0x66, 0x3a, 0x55, 0xf3, 0x9d, 0xdb, 0xf8, 0x3e, 0x00, 0xed, 0xc9, 0x09, 0xcd, 0xa8,
+	0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -444,6 +463,10 @@ type QueryClient interface {
 	//
 	// Since: cosmos-sdk 0.46
 	GranteeGrants(ctx context.Context, in *QueryGranteeGrantsRequest, opts ...grpc.CallOption) (*QueryGranteeGrantsResponse, error)
+	// GranteeGrantsStream streams the list of `GrantAuthorization` by grantee,
+	// one page of grants per response. The pagination of the request defines
+	// the size of each page and where to start from.
+	GranteeGrantsStream(ctx context.Context, in *QueryGranteeGrantsRequest, opts ...grpc.CallOption) (Query_GranteeGrantsStreamClient, error)
 }
 
 type queryClient struct {
@@ -481,6 +504,38 @@ func (c *queryClient) GranteeGrants(ctx context.Context, in *QueryGranteeGrantsR
 	return out, nil
 }
 
+func (c *queryClient) GranteeGrantsStream(ctx context.Context, in *QueryGranteeGrantsRequest, opts ...grpc.CallOption) (Query_GranteeGrantsStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Query_serviceDesc.Streams[0], "/cosmos.authz.v1beta1.Query/GranteeGrantsStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &queryGranteeGrantsStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Query_GranteeGrantsStreamClient interface {
+	Recv() (*QueryGranteeGrantsResponse, error)
+	grpc.ClientStream
+}
+
+type queryGranteeGrantsStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *queryGranteeGrantsStreamClient) Recv() (*QueryGranteeGrantsResponse, error) {
+	m := new(QueryGranteeGrantsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Returns list of `Authorization`, granted to the grantee by the granter.
@@ -493,6 +548,10 @@ type QueryServer interface {
 	//
 	// Since: cosmos-sdk 0.46
 	GranteeGrants(context.Context, *QueryGranteeGrantsRequest) (*QueryGranteeGrantsResponse, error)
+	// GranteeGrantsStream streams the list of `GrantAuthorization` by grantee,
+	// one page of grants per response. The pagination of the request defines
+	// the size of each page and where to start from.
+	GranteeGrantsStream(*QueryGranteeGrantsRequest, Query_GranteeGrantsStreamServer) error
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -508,6 +567,9 @@ func (*UnimplementedQueryServer) GranterGrants(ctx context.Context, req *QueryGr
 func (*UnimplementedQueryServer) GranteeGrants(ctx context.Context, req *QueryGranteeGrantsRequest) (*QueryGranteeGrantsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GranteeGrants not implemented")
 }
+func (*UnimplementedQueryServer) GranteeGrantsStream(req *QueryGranteeGrantsRequest, srv Query_GranteeGrantsStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method GranteeGrantsStream not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -567,6 +629,27 @@ func _Query_GranteeGrants_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_GranteeGrantsStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(QueryGranteeGrantsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(QueryServer).GranteeGrantsStream(m, &queryGranteeGrantsStreamServer{stream})
+}
+
+type Query_GranteeGrantsStreamServer interface {
+	Send(*QueryGranteeGrantsResponse) error
+	grpc.ServerStream
+}
+
+type queryGranteeGrantsStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *queryGranteeGrantsStreamServer) Send(m *QueryGranteeGrantsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.authz.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -584,7 +667,13 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			Handler:    _Query_GranteeGrants_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "GranteeGrantsStream",
+			Handler:       _Query_GranteeGrantsStream_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "cosmos/authz/v1beta1/query.proto",
 }
 
@@ -713,6 +802,13 @@ func (m *QueryGranterGrantsRequest) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	_ = i
 	var l int
 	_ = l
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.Pagination != nil {
 		{
 			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
@@ -804,6 +900,13 @@ func (m *QueryGranteeGrantsRequest) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	_ = i
 	var l int
 	_ = l
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.Pagination != nil {
 		{
 			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
@@ -944,6 +1047,10 @@ func (m *QueryGranterGrantsRequest) Size() (n int) {
 		l = m.Pagination.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -980,6 +1087,10 @@ func (m *QueryGranteeGrantsRequest) Size() (n int) {
 		l = m.Pagination.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -1407,6 +1518,38 @@ func (m *QueryGranterGrantsRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1645,6 +1788,38 @@ func (m *QueryGranteeGrantsRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
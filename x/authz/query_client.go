@@ -0,0 +1,35 @@
+package authz
+
+import (
+	"context"
+	"time"
+
+	gogogrpc "github.com/cosmos/gogoproto/grpc"
+	"google.golang.org/grpc"
+)
+
+// NewQueryClientWithTimeout returns a QueryClient whose unary calls are bounded
+// by defaultTimeout when the context of the caller has no deadline. Calls made
+// with a context that already has a deadline, as well as server-streaming
+// calls, are left untouched.
+func NewQueryClientWithTimeout(cc gogogrpc.ClientConn, defaultTimeout time.Duration) QueryClient {
+	return NewQueryClient(timeoutClientConn{ClientConn: cc, timeout: defaultTimeout})
+}
+
+// timeoutClientConn is a gogogrpc.ClientConn adding a default deadline to the
+// unary calls of its underlying connection.
+type timeoutClientConn struct {
+	gogogrpc.ClientConn
+	timeout time.Duration
+}
+
+// Invoke implements the gogogrpc.ClientConn interface.
+func (cc timeoutClientConn) Invoke(ctx context.Context, method string, args, reply interface{}, opts ...grpc.CallOption) error {
+	if _, ok := ctx.Deadline(); !ok && cc.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, cc.timeout)
+		defer cancel()
+	}
+
+	return cc.ClientConn.Invoke(ctx, method, args, reply, opts...)
+}
@@ -0,0 +1,60 @@
+package authz_test
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+
+	"cosmossdk.io/x/authz"
+)
+
+// slowQueryServer answers Grants queries after delay, or not at all if the
+// call is canceled before.
+type slowQueryServer struct {
+	authz.UnimplementedQueryServer
+	delay time.Duration
+}
+
+func (s *slowQueryServer) Grants(ctx context.Context, _ *authz.QueryGrantsRequest) (*authz.QueryGrantsResponse, error) {
+	select {
+	case <-time.After(s.delay):
+		return &authz.QueryGrantsResponse{}, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func TestNewQueryClientWithTimeout(t *testing.T) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	server := grpc.NewServer()
+	authz.RegisterQueryServer(server, &slowQueryServer{delay: 200 * time.Millisecond})
+	go func() { _ = server.Serve(lis) }()
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.Dial(lis.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+
+	// the default timeout fires before the slow server answers
+	client := authz.NewQueryClientWithTimeout(conn, 20*time.Millisecond)
+	start := time.Now()
+	_, err = client.Grants(context.Background(), &authz.QueryGrantsRequest{})
+	require.Error(t, err)
+	require.Equal(t, codes.DeadlineExceeded, status.Code(err))
+	require.Less(t, time.Since(start), 200*time.Millisecond)
+
+	// the deadline of the caller takes precedence over the default timeout
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	_, err = client.Grants(ctx, &authz.QueryGrantsRequest{})
+	require.NoError(t, err)
+}
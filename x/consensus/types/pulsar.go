@@ -0,0 +1,44 @@
+package types
+
+import (
+	cmtv1 "buf.build/gen/go/tendermint/tendermint/protocolbuffers/go/tendermint/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	protov2 "google.golang.org/protobuf/proto"
+)
+
+// ConsensusParamsToPulsar converts CometBFT consensus params to the generated
+// pulsar tendermint.types.ConsensusParams by round-tripping their wire bytes,
+// so that no field is lost to manual copying. Fields unknown to the pulsar
+// types are kept as unknown fields of the returned message. An error is
+// returned if a string field, e.g. a public key type, is not valid UTF-8.
+func ConsensusParamsToPulsar(cp cmtproto.ConsensusParams) (*cmtv1.ConsensusParams, error) {
+	bz, err := cp.Marshal()
+	if err != nil {
+		return nil, err
+	}
+
+	var res cmtv1.ConsensusParams
+	if err := protov2.Unmarshal(bz, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// ConsensusParamsFromPulsar converts the generated pulsar
+// tendermint.types.ConsensusParams to CometBFT consensus params by
+// round-tripping their wire bytes, unknown fields included. Fields unknown to
+// the CometBFT types are dropped, as they do not retain unknown fields.
+func ConsensusParamsFromPulsar(cp *cmtv1.ConsensusParams) (cmtproto.ConsensusParams, error) {
+	bz, err := protov2.Marshal(cp)
+	if err != nil {
+		return cmtproto.ConsensusParams{}, err
+	}
+
+	var res cmtproto.ConsensusParams
+	if err := res.Unmarshal(bz); err != nil {
+		return cmtproto.ConsensusParams{}, err
+	}
+
+	return res, nil
+}
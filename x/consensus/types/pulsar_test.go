@@ -0,0 +1,84 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+	protov2 "google.golang.org/protobuf/proto"
+
+	"github.com/cosmos/cosmos-sdk/x/consensus/types"
+)
+
+func FuzzConsensusParamsPulsarRoundTrip(f *testing.F) {
+	f.Add(int64(200000), int64(-1), int64(100000), int64(48*time.Hour), int64(1048576), "ed25519", uint64(0), int64(0))
+	f.Add(int64(0), int64(0), int64(0), int64(0), int64(0), "", uint64(0), int64(0))
+	f.Add(int64(1), int64(10000000), int64(1), int64(-time.Second), int64(-1), "secp256k1", uint64(1<<63), int64(12))
+
+	f.Fuzz(func(t *testing.T, maxBytes, maxGas, maxAgeNumBlocks, maxAgeDuration, evidenceMaxBytes int64, pubKeyType string, app uint64, voteExtensionsEnableHeight int64) {
+		if !utf8.ValidString(pubKeyType) {
+			t.Skip("proto3 strings must be valid UTF-8")
+		}
+
+		cp := cmtproto.ConsensusParams{
+			Block: &cmtproto.BlockParams{
+				MaxBytes: maxBytes,
+				MaxGas:   maxGas,
+			},
+			Evidence: &cmtproto.EvidenceParams{
+				MaxAgeNumBlocks: maxAgeNumBlocks,
+				MaxAgeDuration:  time.Duration(maxAgeDuration),
+				MaxBytes:        evidenceMaxBytes,
+			},
+			Validator: &cmtproto.ValidatorParams{
+				PubKeyTypes: []string{pubKeyType},
+			},
+			Version: &cmtproto.VersionParams{
+				App: app,
+			},
+			Abci: &cmtproto.ABCIParams{
+				VoteExtensionsEnableHeight: voteExtensionsEnableHeight,
+			},
+		}
+
+		pulsarCp, err := types.ConsensusParamsToPulsar(cp)
+		require.NoError(t, err)
+		require.Equal(t, maxBytes, pulsarCp.GetBlock().GetMaxBytes())
+		require.Equal(t, maxGas, pulsarCp.GetBlock().GetMaxGas())
+		require.Equal(t, maxAgeNumBlocks, pulsarCp.GetEvidence().GetMaxAgeNumBlocks())
+		require.Equal(t, time.Duration(maxAgeDuration), pulsarCp.GetEvidence().GetMaxAgeDuration().AsDuration())
+		require.Equal(t, []string{pubKeyType}, pulsarCp.GetValidator().GetPubKeyTypes())
+		require.Equal(t, app, pulsarCp.GetVersion().GetApp())
+		require.Equal(t, voteExtensionsEnableHeight, pulsarCp.GetAbci().GetVoteExtensionsEnableHeight())
+
+		res, err := types.ConsensusParamsFromPulsar(pulsarCp)
+		require.NoError(t, err)
+		require.Equal(t, cp, res)
+
+		again, err := types.ConsensusParamsToPulsar(res)
+		require.NoError(t, err)
+		require.True(t, protov2.Equal(pulsarCp, again))
+	})
+}
+
+func TestConsensusParamsFromPulsar_UnknownFields(t *testing.T) {
+	cp := cmtproto.ConsensusParams{
+		Block: &cmtproto.BlockParams{MaxBytes: 100, MaxGas: 200},
+		Abci:  &cmtproto.ABCIParams{VoteExtensionsEnableHeight: 7},
+	}
+	pulsarCp, err := types.ConsensusParamsToPulsar(cp)
+	require.NoError(t, err)
+
+	// a field unknown to both types is dropped by CometBFT's decoding
+	var unknown []byte
+	unknown = protowire.AppendTag(unknown, 100, protowire.VarintType)
+	unknown = protowire.AppendVarint(unknown, 1)
+	pulsarCp.ProtoReflect().SetUnknown(unknown)
+
+	res, err := types.ConsensusParamsFromPulsar(pulsarCp)
+	require.NoError(t, err)
+	require.Equal(t, cp, res)
+}
@@ -29,6 +29,23 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Use:       "annual-provisions",
 					Short:     "Query the current minting annual provisions value",
 				},
+				{
+					RpcMethod: "LastInflationInputs",
+					Use:       "last-inflation-inputs",
+					Short:     "Query the inputs used by the last inflation computation",
+				},
+				{
+					RpcMethod: "InflationHistory",
+					Use:       "inflation-history",
+					Short:     "Query the minting inflation values of the last blocks",
+				},
+				{
+					RpcMethod:      "ProjectedProvisions",
+					Use:            "projected-provisions [bonded-ratio]",
+					Short:          "Query the inflation and annual provisions projected for a bonded ratio",
+					Example:        fmt.Sprintf("%s query mint projected-provisions 0.5", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "bonded_ratio"}},
+				},
 			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
@@ -1,6 +1,11 @@
 package mint
 
 import (
+	"fmt"
+	"sort"
+
+	"golang.org/x/exp/maps"
+
 	modulev1 "cosmossdk.io/api/cosmos/mint/module/v1"
 	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/depinject"
@@ -20,6 +25,7 @@ func (am AppModule) IsOnePerModuleType() {}
 func init() {
 	appconfig.RegisterModule(&modulev1.Module{},
 		appconfig.Provide(ProvideModule),
+		appconfig.Invoke(InvokeSetMintHooks),
 	)
 }
 
@@ -40,7 +46,7 @@ type ModuleInputs struct {
 type ModuleOutputs struct {
 	depinject.Out
 
-	MintKeeper keeper.Keeper
+	MintKeeper *keeper.Keeper
 	Module     appmodule.AppModule
 }
 
@@ -71,8 +77,56 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 		as,
 	)
 
+	k.SetEnforceBondDenom(in.Config.EnforceBondDenom)
+	if in.Config.MintFailurePolicy != "" {
+		policy, err := types.ParseMintFailurePolicy(in.Config.MintFailurePolicy)
+		if err != nil {
+			panic(err)
+		}
+		k.SetMintFailurePolicy(policy)
+	}
+
 	// when no inflation calculation function is provided it will use the default types.DefaultInflationCalculationFn
-	m := NewAppModule(in.Cdc, k, in.AccountKeeper, in.InflationCalculationFn)
+	m := NewAppModule(in.Cdc, &k, in.AccountKeeper, in.InflationCalculationFn)
+
+	return ModuleOutputs{MintKeeper: &k, Module: m}
+}
+
+func InvokeSetMintHooks(
+	config *modulev1.Module,
+	keeper *keeper.Keeper,
+	mintHooks map[string]types.MintHooksWrapper,
+) error {
+	// all arguments to invokers are optional
+	if keeper == nil || config == nil {
+		return nil
+	}
+
+	modNames := maps.Keys(mintHooks)
+	order := config.HooksOrder
+	if len(order) == 0 {
+		order = modNames
+		sort.Strings(order)
+	}
+
+	if len(order) != len(modNames) {
+		return fmt.Errorf("len(hooks_order: %v) != len(hooks modules: %v)", order, modNames)
+	}
+
+	if len(modNames) == 0 {
+		return nil
+	}
+
+	var multiHooks types.MultiMintHooks
+	for _, modName := range order {
+		hook, ok := mintHooks[modName]
+		if !ok {
+			return fmt.Errorf("can't find mint hooks for module %s", modName)
+		}
+
+		multiHooks = append(multiHooks, hook)
+	}
 
-	return ModuleOutputs{MintKeeper: k, Module: m}
+	keeper.SetHooks(multiHooks)
+	return nil
 }
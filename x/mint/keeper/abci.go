@@ -5,6 +5,8 @@ import (
 	"time"
 
 	"cosmossdk.io/core/event"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
 	"cosmossdk.io/x/mint/types"
 
 	"github.com/cosmos/cosmos-sdk/telemetry"
@@ -26,6 +28,17 @@ func (k Keeper) BeginBlocker(ctx context.Context, ic types.InflationCalculationF
 		return err
 	}
 
+	if k.enforceBondDenom {
+		bondDenom, err := k.stakingKeeper.BondDenom(ctx)
+		if err != nil {
+			return err
+		}
+
+		if params.MintDenom != bondDenom {
+			return errorsmod.Wrapf(types.ErrBondDenomMismatch, "mint denom %s, bond denom %s", params.MintDenom, bondDenom)
+		}
+	}
+
 	// recalculate inflation rate
 	totalStakingSupply, err := k.StakingTokenSupply(ctx)
 	if err != nil {
@@ -37,29 +50,59 @@ func (k Keeper) BeginBlocker(ctx context.Context, ic types.InflationCalculationF
 		return err
 	}
 
-	minter.Inflation = ic(ctx, minter, params, bondedRatio)
+	inputs := types.InflationInputs{
+		Height:            k.environment.HeaderService.GetHeaderInfo(ctx).Height,
+		BondedRatio:       bondedRatio,
+		PreviousInflation: minter.Inflation,
+	}
+
+	// limit the change of inflation between blocks, whatever the calculation
+	minter.Inflation = minter.ClampInflationChange(params, ic(ctx, minter, params, bondedRatio))
 	minter.AnnualProvisions = minter.NextAnnualProvisions(params, totalStakingSupply)
 	if err = k.Minter.Set(ctx, minter); err != nil {
 		return err
 	}
 
-	// mint coins, update supply
-	mintedCoin := minter.BlockProvision(params)
-	mintedCoins := sdk.NewCoins(mintedCoin)
-
-	err = k.MintCoins(ctx, mintedCoins)
-	if err != nil {
+	inputs.Inflation = minter.Inflation
+	if err = k.InflationInputs.Set(ctx, inputs); err != nil {
 		return err
 	}
 
-	// send the minted coins to the fee collector account
-	err = k.AddCollectedFees(ctx, mintedCoins)
-	if err != nil {
+	if err = k.recordInflation(ctx, types.InflationRecord{
+		Height:           inputs.Height,
+		Inflation:        minter.Inflation,
+		AnnualProvisions: minter.AnnualProvisions,
+		BondedRatio:      bondedRatio,
+	}, params.InflationHistorySize); err != nil {
 		return err
 	}
 
-	if mintedCoin.Amount.IsInt64() {
-		defer telemetry.ModuleSetGauge(types.ModuleName, float32(mintedCoin.Amount.Int64()), "minted_tokens")
+	// mint coins, update supply
+	mintedCoin := minter.BlockProvision(params)
+
+	// skip minting and fee collection when there is nothing to mint
+	if mintedCoin.Amount.IsPositive() {
+		mintedCoins := sdk.NewCoins(mintedCoin)
+
+		skipped, err := k.mintBlockProvision(ctx, mintedCoins)
+		if err != nil {
+			return err
+		}
+
+		if skipped {
+			// nothing was minted for this block
+			mintedCoin.Amount = math.ZeroInt()
+		} else {
+			if k.hooks != nil {
+				if err = k.hooks.AfterMint(ctx, mintedCoins); err != nil {
+					return err
+				}
+			}
+
+			if mintedCoin.Amount.IsInt64() {
+				defer telemetry.ModuleSetGauge(types.ModuleName, float32(mintedCoin.Amount.Int64()), "minted_tokens")
+			}
+		}
 	}
 
 	return k.environment.EventService.EventManager(ctx).EmitKV(
@@ -70,3 +113,34 @@ func (k Keeper) BeginBlocker(ctx context.Context, ic types.InflationCalculationF
 		event.NewAttribute(sdk.AttributeKeyAmount, mintedCoin.Amount.String()),
 	)
 }
+
+// mintBlockProvision mints the given coins and sends them to the fee
+// collector. Under MintFailurePolicySkip, a failure of either step is reverted,
+// logged and reported by an EventTypeMintFailure event, and skipped is true.
+func (k Keeper) mintBlockProvision(ctx context.Context, coins sdk.Coins) (skipped bool, err error) {
+	mintAndCollect := func(ctx context.Context) error {
+		if err := k.MintCoins(ctx, coins); err != nil {
+			return err
+		}
+
+		// send the minted coins to the fee collector account
+		return k.AddCollectedFees(ctx, coins)
+	}
+
+	if k.mintFailurePolicy != types.MintFailurePolicySkip {
+		return false, mintAndCollect(ctx)
+	}
+
+	err = k.environment.BranchService.Execute(ctx, mintAndCollect)
+	if err == nil {
+		return false, nil
+	}
+
+	k.Logger(ctx).Error("failed to mint block provision; skipping minting for this block", "amount", coins.String(), "err", err)
+
+	return true, k.environment.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeMintFailure,
+		event.NewAttribute(sdk.AttributeKeyAmount, coins.String()),
+		event.NewAttribute(types.AttributeKeyError, err.Error()),
+	)
+}
@@ -0,0 +1,310 @@
+package keeper_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+
+	"github.com/golang/mock/gomock"
+
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	authtypes "cosmossdk.io/x/auth/types"
+	"cosmossdk.io/x/mint/keeper"
+	"cosmossdk.io/x/mint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (s *IntegrationTestSuite) TestBeginBlockerStoresInflationInputs() {
+	ctx := s.ctx.WithHeaderInfo(header.Info{Height: 10})
+	bondedRatio := math.LegacyNewDecWithPrec(15, 2)
+
+	s.stakingKeeper.EXPECT().StakingTokenSupply(ctx).Return(math.NewIntFromUint64(100000000000), nil)
+	s.stakingKeeper.EXPECT().BondedRatio(ctx).Return(bondedRatio, nil)
+	s.bankKeeper.EXPECT().MintCoins(ctx, types.ModuleName, gomock.Any()).Return(nil)
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(ctx, types.ModuleName, authtypes.FeeCollectorName, gomock.Any()).Return(nil)
+
+	prevMinter, err := s.mintKeeper.Minter.Get(ctx)
+	s.Require().NoError(err)
+
+	s.Require().NoError(s.mintKeeper.BeginBlocker(ctx, types.DefaultInflationCalculationFn))
+
+	minter, err := s.mintKeeper.Minter.Get(ctx)
+	s.Require().NoError(err)
+
+	inputs, err := s.mintKeeper.LastInflationInputs(ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(int64(10), inputs.Height)
+	s.Require().Equal(bondedRatio, inputs.BondedRatio)
+	s.Require().Equal(prevMinter.Inflation, inputs.PreviousInflation)
+	s.Require().Equal(minter.Inflation, inputs.Inflation)
+}
+
+func (s *IntegrationTestSuite) TestBeginBlockerSkipsZeroProvisions() {
+	zeroInflation := func(_ context.Context, _ types.Minter, _ types.Params, _ math.LegacyDec) math.LegacyDec {
+		return math.LegacyZeroDec()
+	}
+
+	s.stakingKeeper.EXPECT().StakingTokenSupply(s.ctx).Return(math.NewIntFromUint64(100000000000), nil)
+	s.stakingKeeper.EXPECT().BondedRatio(s.ctx).Return(math.LegacyNewDecWithPrec(99, 2), nil)
+	// no MintCoins or SendCoinsFromModuleToModule calls are expected
+
+	s.Require().NoError(s.mintKeeper.BeginBlocker(s.ctx, zeroInflation))
+
+	minter, err := s.mintKeeper.Minter.Get(s.ctx)
+	s.Require().NoError(err)
+	s.Require().True(minter.Inflation.IsZero())
+	s.Require().True(minter.AnnualProvisions.IsZero())
+}
+
+func (s *IntegrationTestSuite) TestBeginBlockerEnforceBondDenom() {
+	params := types.DefaultParams()
+	params.MintDenom = "notstake"
+	s.Require().NoError(s.mintKeeper.Params.Set(s.ctx, params))
+
+	// the mismatch is ignored by default
+	s.stakingKeeper.EXPECT().StakingTokenSupply(s.ctx).Return(math.NewIntFromUint64(100000000000), nil)
+	s.stakingKeeper.EXPECT().BondedRatio(s.ctx).Return(math.LegacyNewDecWithPrec(15, 2), nil)
+	s.bankKeeper.EXPECT().MintCoins(s.ctx, types.ModuleName, gomock.Any()).Return(nil)
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(s.ctx, types.ModuleName, authtypes.FeeCollectorName, gomock.Any()).Return(nil)
+	s.Require().NoError(s.mintKeeper.BeginBlocker(s.ctx, types.DefaultInflationCalculationFn))
+
+	// once enforced, nothing is minted on mismatch
+	s.mintKeeper.SetEnforceBondDenom(true)
+	s.stakingKeeper.EXPECT().BondDenom(s.ctx).Return("stake", nil)
+	err := s.mintKeeper.BeginBlocker(s.ctx, types.DefaultInflationCalculationFn)
+	s.Require().ErrorIs(err, types.ErrBondDenomMismatch)
+	s.Require().ErrorContains(err, "mint denom notstake, bond denom stake")
+}
+
+func (s *IntegrationTestSuite) TestBeginBlockerClampsInflationChange() {
+	// a curve computing the inflation directly from the bonded ratio, between
+	// the 20% max and 7% min inflation
+	curve := func(_ context.Context, _ types.Minter, params types.Params, bondedRatio math.LegacyDec) math.LegacyDec {
+		return params.InflationMax.Sub(params.InflationMax.Sub(params.InflationMin).Mul(bondedRatio))
+	}
+
+	params := types.DefaultParams()
+	params.MaxInflationChangePerBlock = math.LegacyNewDecWithPrec(1, 2)
+	s.Require().NoError(s.mintKeeper.Params.Set(s.ctx, params))
+	s.Require().NoError(s.mintKeeper.Minter.Set(s.ctx, types.InitialMinter(math.LegacyNewDecWithPrec(10, 2))))
+
+	// the bonded ratio swings from ~77% to 0%, which would move the
+	// inflation from 10% to 20% at once
+	s.stakingKeeper.EXPECT().StakingTokenSupply(s.ctx).Return(math.NewIntFromUint64(100000000000), nil).AnyTimes()
+	s.stakingKeeper.EXPECT().BondedRatio(s.ctx).Return(math.LegacyZeroDec(), nil).AnyTimes()
+	s.bankKeeper.EXPECT().MintCoins(s.ctx, types.ModuleName, gomock.Any()).Return(nil).AnyTimes()
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(s.ctx, types.ModuleName, authtypes.FeeCollectorName, gomock.Any()).Return(nil).AnyTimes()
+
+	for _, expInflation := range []math.LegacyDec{
+		math.LegacyNewDecWithPrec(11, 2),
+		math.LegacyNewDecWithPrec(12, 2),
+		math.LegacyNewDecWithPrec(13, 2),
+	} {
+		s.Require().NoError(s.mintKeeper.BeginBlocker(s.ctx, curve))
+
+		minter, err := s.mintKeeper.Minter.Get(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(expInflation, minter.Inflation)
+	}
+
+	// without a limit the inflation reaches the curve at once
+	params.MaxInflationChangePerBlock = math.LegacyZeroDec()
+	s.Require().NoError(s.mintKeeper.Params.Set(s.ctx, params))
+	s.Require().NoError(s.mintKeeper.BeginBlocker(s.ctx, curve))
+
+	minter, err := s.mintKeeper.Minter.Get(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(params.InflationMax, minter.Inflation)
+}
+
+func (s *IntegrationTestSuite) TestBeginBlockerInflationHistory() {
+	params := types.DefaultParams()
+	params.InflationHistorySize = 3
+	s.Require().NoError(s.mintKeeper.Params.Set(s.ctx, params))
+
+	s.stakingKeeper.EXPECT().StakingTokenSupply(gomock.Any()).Return(math.NewIntFromUint64(100000000000), nil).AnyTimes()
+	s.stakingKeeper.EXPECT().BondedRatio(gomock.Any()).Return(math.LegacyNewDecWithPrec(15, 2), nil).AnyTimes()
+	s.bankKeeper.EXPECT().MintCoins(gomock.Any(), types.ModuleName, gomock.Any()).Return(nil).AnyTimes()
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), types.ModuleName, authtypes.FeeCollectorName, gomock.Any()).Return(nil).AnyTimes()
+
+	queryServer := keeper.NewQueryServerImpl(s.mintKeeper)
+	var expRecords []types.InflationRecord
+	for height := int64(1); height <= 5; height++ {
+		ctx := s.ctx.WithHeaderInfo(header.Info{Height: height})
+		s.Require().NoError(s.mintKeeper.BeginBlocker(ctx, types.DefaultInflationCalculationFn))
+
+		minter, err := s.mintKeeper.Minter.Get(ctx)
+		s.Require().NoError(err)
+		expRecords = append(expRecords, types.InflationRecord{
+			Height:           height,
+			Inflation:        minter.Inflation,
+			AnnualProvisions: minter.AnnualProvisions,
+			BondedRatio:      math.LegacyNewDecWithPrec(15, 2),
+		})
+		// once full, the buffer wraps and only keeps the last 3 blocks
+		if len(expRecords) > 3 {
+			expRecords = expRecords[1:]
+		}
+
+		res, err := queryServer.InflationHistory(ctx, &types.QueryInflationHistoryRequest{})
+		s.Require().NoError(err)
+		s.Require().Equal(expRecords, res.Records)
+	}
+
+	// the slot of height 4 was overwritten by height 5 wrapping around
+	record, err := s.mintKeeper.InflationHistory.Get(s.ctx, 2)
+	s.Require().NoError(err)
+	s.Require().Equal(int64(5), record.Height)
+
+	// shrinking the history drops the slots beyond the new size, and the
+	// records which are too old for it
+	params.InflationHistorySize = 2
+	s.Require().NoError(s.mintKeeper.Params.Set(s.ctx, params))
+
+	var records []types.InflationRecord
+	for _, expHeights := range [][]int64{{6}, {6, 7}, {7, 8}} {
+		ctx := s.ctx.WithHeaderInfo(header.Info{Height: expHeights[len(expHeights)-1]})
+		s.Require().NoError(s.mintKeeper.BeginBlocker(ctx, types.DefaultInflationCalculationFn))
+
+		records, err = s.mintKeeper.GetInflationHistory(ctx)
+		s.Require().NoError(err)
+		s.Require().Len(records, len(expHeights))
+		for i, height := range expHeights {
+			s.Require().Equal(height, records[i].Height)
+		}
+
+		has, err := s.mintKeeper.InflationHistory.Has(ctx, 2)
+		s.Require().NoError(err)
+		s.Require().False(has)
+	}
+
+	// a zero size disables the history
+	params.InflationHistorySize = 0
+	s.Require().NoError(s.mintKeeper.Params.Set(s.ctx, params))
+	ctx := s.ctx.WithHeaderInfo(header.Info{Height: 9})
+	s.Require().NoError(s.mintKeeper.BeginBlocker(ctx, types.DefaultInflationCalculationFn))
+
+	records, err = s.mintKeeper.GetInflationHistory(ctx)
+	s.Require().NoError(err)
+	s.Require().Empty(records)
+}
+
+// mintHooksRecorder records the calls to its AfterMint hook.
+type mintHooksRecorder struct {
+	name  string
+	calls *[]string
+	ctxs  []context.Context
+	coins []sdk.Coins
+}
+
+func (h *mintHooksRecorder) AfterMint(ctx context.Context, mintedCoins sdk.Coins) error {
+	*h.calls = append(*h.calls, h.name)
+	h.ctxs = append(h.ctxs, ctx)
+	h.coins = append(h.coins, mintedCoins)
+	return nil
+}
+
+func (s *IntegrationTestSuite) TestBeginBlockerAfterMintHooks() {
+	var calls []string
+	first := &mintHooksRecorder{name: "first", calls: &calls}
+	second := &mintHooksRecorder{name: "second", calls: &calls}
+	s.mintKeeper.SetHooks(types.NewMultiMintHooks(first, second))
+	s.Require().Panics(func() { s.mintKeeper.SetHooks(first) })
+
+	ctx := s.ctx.WithHeaderInfo(header.Info{Height: 10})
+	s.stakingKeeper.EXPECT().StakingTokenSupply(ctx).Return(math.NewIntFromUint64(100000000000), nil)
+	s.stakingKeeper.EXPECT().BondedRatio(ctx).Return(math.LegacyNewDecWithPrec(15, 2), nil)
+	s.bankKeeper.EXPECT().MintCoins(ctx, types.ModuleName, gomock.Any()).Return(nil)
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(ctx, types.ModuleName, authtypes.FeeCollectorName, gomock.Any()).Return(nil)
+
+	s.Require().NoError(s.mintKeeper.BeginBlocker(ctx, types.DefaultInflationCalculationFn))
+
+	minter, err := s.mintKeeper.Minter.Get(ctx)
+	s.Require().NoError(err)
+	params, err := s.mintKeeper.Params.Get(ctx)
+	s.Require().NoError(err)
+	expectedCoins := sdk.NewCoins(minter.BlockProvision(params))
+	s.Require().False(expectedCoins.IsZero())
+
+	// the hooks are called in registration order, with the block context
+	s.Require().Equal([]string{"first", "second"}, calls)
+	for _, hook := range []*mintHooksRecorder{first, second} {
+		s.Require().Equal([]sdk.Coins{expectedCoins}, hook.coins)
+		s.Require().Equal(int64(10), sdk.UnwrapSDKContext(hook.ctxs[0]).HeaderInfo().Height)
+	}
+}
+
+func (s *IntegrationTestSuite) TestBeginBlockerAfterMintHookError() {
+	s.mintKeeper.SetHooks(failingMintHooks{})
+
+	s.stakingKeeper.EXPECT().StakingTokenSupply(s.ctx).Return(math.NewIntFromUint64(100000000000), nil)
+	s.stakingKeeper.EXPECT().BondedRatio(s.ctx).Return(math.LegacyNewDecWithPrec(15, 2), nil)
+	s.bankKeeper.EXPECT().MintCoins(s.ctx, types.ModuleName, gomock.Any()).Return(nil)
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(s.ctx, types.ModuleName, authtypes.FeeCollectorName, gomock.Any()).Return(nil)
+
+	s.Require().EqualError(s.mintKeeper.BeginBlocker(s.ctx, types.DefaultInflationCalculationFn), "hook failure")
+}
+
+type failingMintHooks struct{}
+
+func (failingMintHooks) AfterMint(context.Context, sdk.Coins) error {
+	return errors.New("hook failure")
+}
+
+func (s *IntegrationTestSuite) TestLastInflationInputsNotFound() {
+	_, err := s.mintKeeper.LastInflationInputs(s.ctx)
+	s.Require().Error(err)
+}
+
+func (s *IntegrationTestSuite) TestBeginBlockerMintFailurePolicy() {
+	mintErr := errors.New("supply cap reached")
+
+	// by default the error halts the chain
+	s.stakingKeeper.EXPECT().StakingTokenSupply(s.ctx).Return(math.NewIntFromUint64(100000000000), nil)
+	s.stakingKeeper.EXPECT().BondedRatio(s.ctx).Return(math.LegacyNewDecWithPrec(15, 2), nil)
+	s.bankKeeper.EXPECT().MintCoins(s.ctx, types.ModuleName, gomock.Any()).Return(mintErr)
+	s.Require().ErrorIs(s.mintKeeper.BeginBlocker(s.ctx, types.DefaultInflationCalculationFn), mintErr)
+
+	s.Require().Panics(func() { s.mintKeeper.SetMintFailurePolicy(types.MintFailurePolicy(2)) })
+
+	// with the skip policy, the block continues without minting
+	logBuffer := new(bytes.Buffer)
+	mintKeeper, ctx := s.newKeeperWithLogger(log.NewLogger(logBuffer, log.ColorOption(false)))
+	mintKeeper.SetMintFailurePolicy(types.MintFailurePolicySkip)
+
+	s.stakingKeeper.EXPECT().StakingTokenSupply(gomock.Any()).Return(math.NewIntFromUint64(100000000000), nil)
+	s.stakingKeeper.EXPECT().BondedRatio(gomock.Any()).Return(math.LegacyNewDecWithPrec(15, 2), nil)
+	s.bankKeeper.EXPECT().MintCoins(gomock.Any(), types.ModuleName, gomock.Any()).Return(nil)
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), types.ModuleName, authtypes.FeeCollectorName, gomock.Any()).Return(mintErr)
+	s.Require().NoError(mintKeeper.BeginBlocker(ctx, types.DefaultInflationCalculationFn))
+
+	s.Require().Contains(logBuffer.String(), "failed to mint block provision; skipping minting for this block")
+	s.Require().Contains(logBuffer.String(), "supply cap reached")
+
+	var failure, mint *sdk.Event
+	for _, e := range ctx.EventManager().Events() {
+		e := e
+		switch e.Type {
+		case types.EventTypeMintFailure:
+			failure = &e
+		case types.EventTypeMint:
+			mint = &e
+		}
+	}
+	s.Require().NotNil(failure)
+	errAttr, ok := failure.GetAttribute(types.AttributeKeyError)
+	s.Require().True(ok)
+	s.Require().Equal(mintErr.Error(), errAttr.Value)
+
+	// the minter is still updated, the mint event reports nothing minted
+	s.Require().NotNil(mint)
+	amount, ok := mint.GetAttribute(sdk.AttributeKeyAmount)
+	s.Require().True(ok)
+	s.Require().Equal("0", amount.Value)
+	inputs, err := mintKeeper.LastInflationInputs(ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(int64(ctx.HeaderInfo().Height), inputs.Height)
+}
@@ -3,6 +3,10 @@ package keeper
 import (
 	"context"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"cosmossdk.io/math"
 	"cosmossdk.io/x/mint/types"
 )
 
@@ -45,3 +49,62 @@ func (q queryServer) AnnualProvisions(ctx context.Context, _ *types.QueryAnnualP
 
 	return &types.QueryAnnualProvisionsResponse{AnnualProvisions: minter.AnnualProvisions}, nil
 }
+
+// LastInflationInputs returns the inputs used by the last inflation computation
+// of the mint module.
+func (q queryServer) LastInflationInputs(ctx context.Context, _ *types.QueryLastInflationInputsRequest) (*types.QueryLastInflationInputsResponse, error) {
+	inputs, err := q.k.LastInflationInputs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryLastInflationInputsResponse{Inputs: inputs}, nil
+}
+
+// InflationHistory returns the minter state of the last blocks of the mint
+// module.
+func (q queryServer) InflationHistory(ctx context.Context, _ *types.QueryInflationHistoryRequest) (*types.QueryInflationHistoryResponse, error) {
+	records, err := q.k.GetInflationHistory(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryInflationHistoryResponse{Records: records}, nil
+}
+
+// ProjectedProvisions returns the inflation and annual provisions the next
+// block would have for the requested bonded ratio. The projection follows
+// BeginBlocker with the default inflation calculation, including the
+// MaxInflationChangePerBlock clamp, against the current staking token supply.
+func (q queryServer) ProjectedProvisions(ctx context.Context, req *types.QueryProjectedProvisionsRequest) (*types.QueryProjectedProvisionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.BondedRatio.IsNil() || req.BondedRatio.IsNegative() || req.BondedRatio.GT(math.LegacyOneDec()) {
+		return nil, status.Errorf(codes.InvalidArgument, "bonded ratio must be between 0 and 1, got %s", req.BondedRatio)
+	}
+
+	minter, err := q.k.Minter.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	params, err := q.k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	totalStakingSupply, err := q.k.StakingTokenSupply(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	minter.Inflation = minter.ClampInflationChange(params, minter.NextInflationRate(params, req.BondedRatio))
+	minter.AnnualProvisions = minter.NextAnnualProvisions(params, totalStakingSupply)
+
+	return &types.QueryProjectedProvisionsResponse{
+		Inflation:        minter.Inflation,
+		AnnualProvisions: minter.AnnualProvisions,
+	}, nil
+}
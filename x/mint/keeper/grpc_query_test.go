@@ -8,6 +8,7 @@ import (
 	"github.com/stretchr/testify/suite"
 
 	"cosmossdk.io/log"
+	"cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
 	authtypes "cosmossdk.io/x/auth/types"
 	"cosmossdk.io/x/mint"
@@ -26,9 +27,10 @@ import (
 type MintTestSuite struct {
 	suite.Suite
 
-	ctx         sdk.Context
-	queryClient types.QueryClient
-	mintKeeper  keeper.Keeper
+	ctx           sdk.Context
+	queryClient   types.QueryClient
+	mintKeeper    keeper.Keeper
+	stakingKeeper *minttestutil.MockStakingKeeper
 }
 
 func (suite *MintTestSuite) SetupTest() {
@@ -43,6 +45,7 @@ func (suite *MintTestSuite) SetupTest() {
 	accountKeeper := minttestutil.NewMockAccountKeeper(ctrl)
 	bankKeeper := minttestutil.NewMockBankKeeper(ctrl)
 	stakingKeeper := minttestutil.NewMockStakingKeeper(ctrl)
+	suite.stakingKeeper = stakingKeeper
 
 	accountKeeper.EXPECT().GetModuleAddress("mint").Return(sdk.AccAddress{})
 
@@ -81,6 +84,47 @@ func (suite *MintTestSuite) TestGRPCParams() {
 	annualProvisions, err := suite.queryClient.AnnualProvisions(gocontext.Background(), &types.QueryAnnualProvisionsRequest{})
 	suite.Require().NoError(err)
 	suite.Require().Equal(annualProvisions.AnnualProvisions, minter.AnnualProvisions)
+
+	inputs := types.InflationInputs{
+		Height:            1,
+		BondedRatio:       math.LegacyNewDecWithPrec(5, 1),
+		PreviousInflation: math.LegacyNewDecWithPrec(13, 2),
+		Inflation:         math.LegacyNewDecWithPrec(12, 2),
+	}
+	suite.Require().NoError(suite.mintKeeper.InflationInputs.Set(suite.ctx, inputs))
+	lastInputs, err := suite.queryClient.LastInflationInputs(gocontext.Background(), &types.QueryLastInflationInputsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(inputs, lastInputs.Inputs)
+}
+
+func (suite *MintTestSuite) TestGRPCProjectedProvisions() {
+	supply := math.NewInt(100000000000)
+	suite.stakingKeeper.EXPECT().StakingTokenSupply(suite.ctx).Return(supply, nil).AnyTimes()
+
+	params, err := suite.mintKeeper.Params.Get(suite.ctx)
+	suite.Require().NoError(err)
+	minter, err := suite.mintKeeper.Minter.Get(suite.ctx)
+	suite.Require().NoError(err)
+
+	for _, bondedRatio := range []math.LegacyDec{math.LegacyZeroDec(), math.LegacyNewDecWithPrec(67, 2), math.LegacyOneDec()} {
+		res, err := suite.queryClient.ProjectedProvisions(gocontext.Background(), &types.QueryProjectedProvisionsRequest{BondedRatio: bondedRatio})
+		suite.Require().NoError(err)
+
+		expected := minter
+		expected.Inflation = minter.ClampInflationChange(params, minter.NextInflationRate(params, bondedRatio))
+		suite.Require().Equal(expected.Inflation, res.Inflation, bondedRatio.String())
+		suite.Require().Equal(expected.NextAnnualProvisions(params, supply), res.AnnualProvisions, bondedRatio.String())
+	}
+
+	// the projection does not change the stored minter
+	stored, err := suite.mintKeeper.Minter.Get(suite.ctx)
+	suite.Require().NoError(err)
+	suite.Require().Equal(minter, stored)
+
+	for _, bondedRatio := range []math.LegacyDec{math.LegacyNewDec(-1), math.LegacyNewDecWithPrec(11, 1)} {
+		_, err := suite.queryClient.ProjectedProvisions(gocontext.Background(), &types.QueryProjectedProvisionsRequest{BondedRatio: bondedRatio})
+		suite.Require().ErrorContains(err, "bonded ratio must be between 0 and 1")
+	}
 }
 
 func TestMintTestSuite(t *testing.T) {
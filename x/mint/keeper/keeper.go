@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 	"fmt"
+	"sort"
 
 	"cosmossdk.io/collections"
 	"cosmossdk.io/core/appmodule"
@@ -25,10 +26,22 @@ type Keeper struct {
 	// the address capable of executing a MsgUpdateParams message. Typically, this
 	// should be the x/gov module account.
 	authority string
+	// enforceBondDenom makes BeginBlocker fail when the mint denom differs from
+	// the staking bond denom.
+	enforceBondDenom bool
+	// mintFailurePolicy defines how BeginBlocker handles minting failures.
+	mintFailurePolicy types.MintFailurePolicy
+	// hooks are called after coins are minted in BeginBlocker.
+	hooks types.MintHooks
 
 	Schema collections.Schema
 	Params collections.Item[types.Params]
 	Minter collections.Item[types.Minter]
+	// InflationInputs stores the inputs used by the last inflation computation.
+	InflationInputs collections.Item[types.InflationInputs]
+	// InflationHistory is a ring buffer of the minter state of the last blocks,
+	// indexed by height modulo the inflation history size param.
+	InflationHistory collections.Map[uint32, types.InflationRecord]
 }
 
 // NewKeeper creates a new mint Keeper instance
@@ -57,6 +70,8 @@ func NewKeeper(
 		authority:        authority,
 		Params:           collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
 		Minter:           collections.NewItem(sb, types.MinterKey, "minter", codec.CollValue[types.Minter](cdc)),
+		InflationInputs:  collections.NewItem(sb, types.InflationInputsKey, "inflation_inputs", codec.CollValue[types.InflationInputs](cdc)),
+		InflationHistory: collections.NewMap(sb, types.InflationHistoryKey, "inflation_history", collections.Uint32Key, codec.CollValue[types.InflationRecord](cdc)),
 	}
 
 	schema, err := sb.Build()
@@ -67,6 +82,41 @@ func NewKeeper(
 	return k
 }
 
+// SetEnforceBondDenom sets whether BeginBlocker fails when the mint denom param
+// differs from the staking bond denom, instead of minting rewards in another
+// denom. It is disabled by default for chains that intentionally mint a
+// different denom. With depinject it is set by the enforce_bond_denom module
+// config field.
+func (k *Keeper) SetEnforceBondDenom(enforce bool) {
+	k.enforceBondDenom = enforce
+}
+
+// SetMintFailurePolicy sets how BeginBlocker handles a failure to mint the
+// block provision or to send it to the fee collector, e.g. because a supply cap
+// rejects minting. By default the error is returned and halts the chain. It
+// panics on an unknown policy. With depinject it is set by the
+// mint_failure_policy module config field.
+func (k *Keeper) SetMintFailurePolicy(policy types.MintFailurePolicy) {
+	if err := policy.Validate(); err != nil {
+		panic(err)
+	}
+
+	k.mintFailurePolicy = policy
+}
+
+// SetHooks sets the hooks called after coins are minted in BeginBlocker. Use
+// types.NewMultiMintHooks to register several hooks, which are called in order.
+// With depinject, modules provide a types.MintHooksWrapper instead.
+func (k *Keeper) SetHooks(mh types.MintHooks) *Keeper {
+	if k.hooks != nil {
+		panic("cannot set mint hooks twice")
+	}
+
+	k.hooks = mh
+
+	return k
+}
+
 // GetAuthority returns the x/mint module's authority.
 func (k Keeper) GetAuthority() string {
 	return k.authority
@@ -77,6 +127,55 @@ func (k Keeper) Logger(ctx context.Context) log.Logger {
 	return k.environment.Logger.With("module", "x/"+types.ModuleName)
 }
 
+// LastInflationInputs returns the inputs used by the last inflation computation
+// performed in BeginBlocker.
+func (k Keeper) LastInflationInputs(ctx context.Context) (types.InflationInputs, error) {
+	return k.InflationInputs.Get(ctx)
+}
+
+// recordInflation stores the given record in the inflation history ring
+// buffer of the given size, overwriting the record of the same slot. Slots
+// beyond the size, left by a larger size, are removed, so the history refills
+// after the size is reduced.
+func (k Keeper) recordInflation(ctx context.Context, record types.InflationRecord, size uint32) error {
+	if err := k.InflationHistory.Clear(ctx, new(collections.Range[uint32]).StartInclusive(size)); err != nil {
+		return err
+	}
+
+	if size == 0 {
+		return nil
+	}
+
+	return k.InflationHistory.Set(ctx, uint32(uint64(record.Height)%uint64(size)), record)
+}
+
+// GetInflationHistory returns the records of the inflation history, from the
+// oldest to the most recent. Records older than the inflation history size
+// param, left by a larger size, are skipped.
+func (k Keeper) GetInflationHistory(ctx context.Context) ([]types.InflationRecord, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	iter, err := k.InflationHistory.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	records, err := iter.Values()
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(records, func(i, j int) bool { return records[i].Height < records[j].Height })
+	for len(records) > 0 && records[len(records)-1].Height-records[0].Height >= int64(params.InflationHistorySize) {
+		records = records[1:]
+	}
+
+	return records, nil
+}
+
 // StakingTokenSupply implements an alias call to the underlying staking keeper's
 // StakingTokenSupply to be used in BeginBlocker.
 func (k Keeper) StakingTokenSupply(ctx context.Context) (math.Int, error) {
@@ -76,6 +76,32 @@ func (s *IntegrationTestSuite) SetupTest() {
 	s.msgServer = keeper.NewMsgServerImpl(s.mintKeeper)
 }
 
+// newKeeperWithLogger returns a new mint keeper using the suite mocks, with
+// its own store and the given logger, and the context to use it with.
+func (s *IntegrationTestSuite) newKeeperWithLogger(logger log.Logger) (keeper.Keeper, sdk.Context) {
+	encCfg := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{}, mint.AppModule{})
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	env := runtime.NewEnvironment(runtime.NewKVStoreService(key), logger)
+	ctx := testutil.DefaultContextWithDB(s.T(), key, storetypes.NewTransientStoreKey("transient_test")).Ctx
+
+	accountKeeper := minttestutil.NewMockAccountKeeper(gomock.NewController(s.T()))
+	accountKeeper.EXPECT().GetModuleAddress(types.ModuleName).Return(sdk.AccAddress{})
+
+	mintKeeper := keeper.NewKeeper(
+		encCfg.Codec,
+		env,
+		s.stakingKeeper,
+		accountKeeper,
+		s.bankKeeper,
+		authtypes.FeeCollectorName,
+		govModuleNameStr,
+	)
+	s.Require().NoError(mintKeeper.Params.Set(ctx, types.DefaultParams()))
+	s.Require().NoError(mintKeeper.Minter.Set(ctx, types.DefaultInitialMinter()))
+
+	return mintKeeper, ctx
+}
+
 func (s *IntegrationTestSuite) TestAliasFunctions() {
 	stakingTokenSupply := math.NewIntFromUint64(100000000000)
 	s.stakingKeeper.EXPECT().StakingTokenSupply(s.ctx).Return(stakingTokenSupply, nil)
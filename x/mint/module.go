@@ -40,7 +40,7 @@ var (
 // AppModule implements an application module for the mint module.
 type AppModule struct {
 	cdc        codec.Codec
-	keeper     keeper.Keeper
+	keeper     *keeper.Keeper
 	authKeeper types.AccountKeeper
 
 	// inflationCalculator is used to calculate the inflation rate during BeginBlock.
@@ -52,7 +52,7 @@ type AppModule struct {
 // If the InflationCalculationFn argument is nil, then the SDK's default inflation function will be used.
 func NewAppModule(
 	cdc codec.Codec,
-	keeper keeper.Keeper,
+	keeper *keeper.Keeper,
 	ak types.AccountKeeper,
 	ic types.InflationCalculationFn,
 ) AppModule {
@@ -95,15 +95,15 @@ func (AppModule) RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *gwrunt
 
 // RegisterServices registers module services.
 func (am AppModule) RegisterServices(registrar grpc.ServiceRegistrar) error {
-	types.RegisterMsgServer(registrar, keeper.NewMsgServerImpl(am.keeper))
-	types.RegisterQueryServer(registrar, keeper.NewQueryServerImpl(am.keeper))
+	types.RegisterMsgServer(registrar, keeper.NewMsgServerImpl(*am.keeper))
+	types.RegisterQueryServer(registrar, keeper.NewQueryServerImpl(*am.keeper))
 
 	return nil
 }
 
 // RegisterMigrations registers module migrations.
 func (am AppModule) RegisterMigrations(mr appmodule.MigrationRegistrar) error {
-	m := keeper.NewMigrator(am.keeper)
+	m := keeper.NewMigrator(*am.keeper)
 
 	if err := mr.Register(types.ModuleName, 1, m.Migrate1to2); err != nil {
 		return fmt.Errorf("failed to migrate x/%s from version 1 to 2: %w", types.ModuleName, err)
@@ -2,4 +2,7 @@ package types
 
 import "cosmossdk.io/errors"
 
-var ErrInvalidSigner = errors.Register(ModuleName, 1, "expected authority account as only signer for proposal message")
+var (
+	ErrInvalidSigner     = errors.Register(ModuleName, 1, "expected authority account as only signer for proposal message")
+	ErrBondDenomMismatch = errors.Register(ModuleName, 2, "mint denom does not match the staking bond denom")
+)
@@ -3,8 +3,12 @@ package types
 // Minting module event types
 const (
 	EventTypeMint = ModuleName
+	// EventTypeMintFailure is emitted when minting is skipped for a block per
+	// MintFailurePolicySkip.
+	EventTypeMintFailure = "mint_failure"
 
 	AttributeKeyBondedRatio      = "bonded_ratio"
 	AttributeKeyInflation        = "inflation"
 	AttributeKeyAnnualProvisions = "annual_provisions"
+	AttributeKeyError            = "error"
 )
@@ -13,6 +13,7 @@ import (
 type StakingKeeper interface {
 	StakingTokenSupply(ctx context.Context) (math.Int, error)
 	BondedRatio(ctx context.Context) (math.LegacyDec, error)
+	BondDenom(ctx context.Context) (string, error)
 }
 
 // AccountKeeper defines the contract required for account APIs.
@@ -32,3 +33,16 @@ type BankKeeper interface {
 	SendCoinsFromModuleToModule(ctx context.Context, senderModule, recipientModule string, amt sdk.Coins) error
 	MintCoins(ctx context.Context, name string, amt sdk.Coins) error
 }
+
+// MintHooks defines the hooks called by the mint module.
+type MintHooks interface {
+	// AfterMint is called in BeginBlocker once the minted coins were sent to the
+	// fee collector. It is not called if nothing was minted.
+	AfterMint(ctx context.Context, mintedCoins sdk.Coins) error
+}
+
+// MintHooksWrapper is a wrapper for modules to inject MintHooks using depinject.
+type MintHooksWrapper struct{ MintHooks }
+
+// IsOnePerModuleType implements the depinject.OnePerModuleType interface.
+func (MintHooksWrapper) IsOnePerModuleType() {}
@@ -0,0 +1,50 @@
+package types
+
+import "fmt"
+
+// MintFailurePolicy defines how BeginBlocker handles a failure to mint the
+// block provision or to send it to the fee collector.
+type MintFailurePolicy uint8
+
+const (
+	// MintFailurePolicyHalt makes BeginBlocker return the error, halting the
+	// chain. It is the default.
+	MintFailurePolicyHalt MintFailurePolicy = iota
+	// MintFailurePolicySkip skips minting for the block, reverting any partial
+	// minting, and emits an EventTypeMintFailure event instead.
+	MintFailurePolicySkip
+)
+
+// Validate returns an error if the policy is unknown.
+func (p MintFailurePolicy) Validate() error {
+	switch p {
+	case MintFailurePolicyHalt, MintFailurePolicySkip:
+		return nil
+	default:
+		return fmt.Errorf("unknown mint failure policy: %d", p)
+	}
+}
+
+// ParseMintFailurePolicy returns the policy with the given name, as returned by
+// String.
+func ParseMintFailurePolicy(name string) (MintFailurePolicy, error) {
+	switch name {
+	case "halt":
+		return MintFailurePolicyHalt, nil
+	case "skip":
+		return MintFailurePolicySkip, nil
+	default:
+		return 0, fmt.Errorf("unknown mint failure policy: %q", name)
+	}
+}
+
+func (p MintFailurePolicy) String() string {
+	switch p {
+	case MintFailurePolicyHalt:
+		return "halt"
+	case MintFailurePolicySkip:
+		return "skip"
+	default:
+		return fmt.Sprintf("MintFailurePolicy(%d)", p)
+	}
+}
@@ -0,0 +1,20 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseMintFailurePolicy(t *testing.T) {
+	for _, policy := range []MintFailurePolicy{MintFailurePolicyHalt, MintFailurePolicySkip} {
+		parsed, err := ParseMintFailurePolicy(policy.String())
+		require.NoError(t, err)
+		require.Equal(t, policy, parsed)
+	}
+
+	_, err := ParseMintFailurePolicy("")
+	require.ErrorContains(t, err, "unknown mint failure policy")
+	_, err = ParseMintFailurePolicy(MintFailurePolicy(2).String())
+	require.ErrorContains(t, err, "unknown mint failure policy")
+}
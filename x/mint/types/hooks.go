@@ -0,0 +1,26 @@
+package types
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// combine multiple mint hooks, all hook functions are run in array sequence
+var _ MintHooks = &MultiMintHooks{}
+
+type MultiMintHooks []MintHooks
+
+func NewMultiMintHooks(hooks ...MintHooks) MultiMintHooks {
+	return hooks
+}
+
+func (h MultiMintHooks) AfterMint(ctx context.Context, mintedCoins sdk.Coins) error {
+	for i := range h {
+		if err := h[i].AfterMint(ctx, mintedCoins); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
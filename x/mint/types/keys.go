@@ -4,8 +4,11 @@ import "cosmossdk.io/collections"
 
 var (
 	// MinterKey is the key to use for the keeper store.
-	MinterKey = collections.NewPrefix(0)
-	ParamsKey = collections.NewPrefix(1)
+	MinterKey          = collections.NewPrefix(0)
+	ParamsKey          = collections.NewPrefix(1)
+	InflationInputsKey = collections.NewPrefix(2)
+	// InflationHistoryKey is the prefix of the inflation history ring buffer.
+	InflationHistoryKey = collections.NewPrefix(3)
 )
 
 const (
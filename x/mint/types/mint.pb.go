@@ -81,6 +81,12 @@ type Params struct {
 	GoalBonded cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=goal_bonded,json=goalBonded,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"goal_bonded"`
 	// expected blocks per year
 	BlocksPerYear uint64 `protobuf:"varint,6,opt,name=blocks_per_year,json=blocksPerYear,proto3" json:"blocks_per_year,omitempty"`
+	// maximum change of the inflation rate between consecutive blocks, the
+	// change is not limited if zero
+	MaxInflationChangePerBlock cosmossdk_io_math.LegacyDec `protobuf:"bytes,7,opt,name=max_inflation_change_per_block,json=maxInflationChangePerBlock,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_inflation_change_per_block"`
+	// number of past blocks whose inflation is kept for the InflationHistory
+	// query, the history is disabled if zero
+	InflationHistorySize uint32 `protobuf:"varint,8,opt,name=inflation_history_size,json=inflationHistorySize,proto3" json:"inflation_history_size,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -130,42 +136,167 @@ func (m *Params) GetBlocksPerYear() uint64 {
 	return 0
 }
 
+func (m *Params) GetInflationHistorySize() uint32 {
+	if m != nil {
+		return m.InflationHistorySize
+	}
+	return 0
+}
+
+// InflationInputs records the inputs used by the last inflation computation
+// performed in BeginBlocker, along with its result.
+type InflationInputs struct {
+	// height is the block height at which the inflation was computed.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// bonded_ratio is the bonded ratio passed to the inflation calculation.
+	BondedRatio cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=bonded_ratio,json=bondedRatio,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"bonded_ratio"`
+	// previous_inflation is the minter inflation rate before the computation.
+	PreviousInflation cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=previous_inflation,json=previousInflation,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"previous_inflation"`
+	// inflation is the resulting inflation rate.
+	Inflation cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=inflation,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"inflation"`
+}
+
+func (m *InflationInputs) Reset()         { *m = InflationInputs{} }
+func (m *InflationInputs) String() string { return proto.CompactTextString(m) }
+func (*InflationInputs) ProtoMessage()    {}
+func (*InflationInputs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2df116d183c1e223, []int{2}
+}
+func (m *InflationInputs) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InflationInputs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InflationInputs.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InflationInputs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InflationInputs.Merge(m, src)
+}
+func (m *InflationInputs) XXX_Size() int {
+	return m.Size()
+}
+func (m *InflationInputs) XXX_DiscardUnknown() {
+	xxx_messageInfo_InflationInputs.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InflationInputs proto.InternalMessageInfo
+
+func (m *InflationInputs) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// InflationRecord records the minter state computed in BeginBlocker at a given
+// height.
+type InflationRecord struct {
+	// height is the block height at which the inflation was computed.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// inflation is the inflation rate computed at height.
+	Inflation cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=inflation,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"inflation"`
+	// annual_provisions is the annual provisions computed at height.
+	AnnualProvisions cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=annual_provisions,json=annualProvisions,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"annual_provisions"`
+	// bonded_ratio is the bonded ratio the inflation was computed with.
+	BondedRatio cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=bonded_ratio,json=bondedRatio,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"bonded_ratio"`
+}
+
+func (m *InflationRecord) Reset()         { *m = InflationRecord{} }
+func (m *InflationRecord) String() string { return proto.CompactTextString(m) }
+func (*InflationRecord) ProtoMessage()    {}
+func (*InflationRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2df116d183c1e223, []int{3}
+}
+func (m *InflationRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InflationRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InflationRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InflationRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InflationRecord.Merge(m, src)
+}
+func (m *InflationRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *InflationRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_InflationRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InflationRecord proto.InternalMessageInfo
+
+func (m *InflationRecord) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Minter)(nil), "cosmos.mint.v1beta1.Minter")
 	proto.RegisterType((*Params)(nil), "cosmos.mint.v1beta1.Params")
+	proto.RegisterType((*InflationInputs)(nil), "cosmos.mint.v1beta1.InflationInputs")
+	proto.RegisterType((*InflationRecord)(nil), "cosmos.mint.v1beta1.InflationRecord")
 }
 
 func init() { proto.RegisterFile("cosmos/mint/v1beta1/mint.proto", fileDescriptor_2df116d183c1e223) }
 
 var fileDescriptor_2df116d183c1e223 = []byte{
-	// 426 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0x4f, 0x8b, 0xd3, 0x40,
-	0x18, 0xc6, 0x33, 0xee, 0x1a, 0xe8, 0xe8, 0xa2, 0x3b, 0xab, 0x90, 0x5d, 0xd9, 0xec, 0xb2, 0x07,
-	0x59, 0x0a, 0x4d, 0x28, 0x05, 0x0f, 0x1e, 0x6b, 0x8f, 0x16, 0x4b, 0x2e, 0xa2, 0x82, 0xe1, 0x4d,
-	0x32, 0xa6, 0x63, 0x93, 0x99, 0x32, 0x33, 0x96, 0xf6, 0x2b, 0x78, 0xf2, 0x63, 0x78, 0xec, 0xc1,
-	0x8b, 0xdf, 0xa0, 0xc7, 0xe2, 0x49, 0x3c, 0x14, 0x69, 0x0f, 0x3d, 0xf9, 0x1d, 0x24, 0x99, 0x90,
-	0xa2, 0x37, 0xed, 0x5e, 0x42, 0xe6, 0x79, 0xde, 0xf7, 0xf7, 0x3e, 0xcc, 0x1f, 0xec, 0xc6, 0x42,
-	0xe5, 0x42, 0xf9, 0x39, 0xe3, 0xda, 0x9f, 0xb4, 0x23, 0xaa, 0xa1, 0x5d, 0x2e, 0xbc, 0xb1, 0x14,
-	0x5a, 0x90, 0x13, 0xe3, 0x7b, 0xa5, 0x54, 0xf9, 0x67, 0x0f, 0x52, 0x91, 0x8a, 0xd2, 0xf7, 0x8b,
-	0x3f, 0x53, 0x7a, 0x76, 0x6a, 0x4a, 0x43, 0x63, 0x54, 0x7d, 0xc6, 0x3a, 0x86, 0x9c, 0x71, 0xe1,
-	0x97, 0x5f, 0x23, 0x5d, 0x7d, 0x45, 0xd8, 0xee, 0x33, 0xae, 0xa9, 0x24, 0x2f, 0x70, 0x83, 0xf1,
-	0x77, 0x19, 0x68, 0x26, 0xb8, 0x83, 0x2e, 0xd1, 0x75, 0xa3, 0xdb, 0x5e, 0xac, 0x2e, 0xac, 0x1f,
-	0xab, 0x8b, 0x47, 0x06, 0xa3, 0x92, 0x91, 0xc7, 0x84, 0x9f, 0x83, 0x1e, 0x7a, 0xcf, 0x69, 0x0a,
-	0xf1, 0xac, 0x47, 0xe3, 0x6f, 0x5f, 0x5a, 0xb8, 0x9a, 0xd2, 0xa3, 0x71, 0xb0, 0x63, 0x90, 0xb7,
-	0xf8, 0x18, 0x38, 0xff, 0x00, 0x59, 0x91, 0x65, 0xc2, 0x14, 0x13, 0x5c, 0x39, 0xb7, 0xfe, 0x17,
-	0x7c, 0xdf, 0xb0, 0x06, 0x35, 0xea, 0xea, 0xd7, 0x01, 0xb6, 0x07, 0x20, 0x21, 0x57, 0xe4, 0x1c,
-	0xe3, 0x62, 0x6b, 0xc2, 0x84, 0x72, 0x91, 0x9b, 0xf0, 0x41, 0xa3, 0x50, 0x7a, 0x85, 0x40, 0xde,
-	0xe3, 0x87, 0x75, 0xac, 0x50, 0x82, 0xa6, 0x61, 0x3c, 0x04, 0x9e, 0xd2, 0x2a, 0xcd, 0x93, 0x7f,
-	0x4e, 0xf3, 0x79, 0x3b, 0x6f, 0xa2, 0xe0, 0xa4, 0x86, 0x06, 0xa0, 0xe9, 0xb3, 0x12, 0x49, 0xde,
-	0xe0, 0xa3, 0xdd, 0xac, 0x1c, 0xa6, 0xce, 0xc1, 0x5e, 0x33, 0xee, 0xd6, 0xb0, 0x3e, 0x4c, 0xff,
-	0x82, 0x33, 0xee, 0x1c, 0xde, 0x14, 0x9c, 0x71, 0xf2, 0x12, 0xdf, 0x49, 0x05, 0x64, 0x61, 0x24,
-	0x78, 0x42, 0x13, 0xe7, 0xf6, 0x5e, 0x68, 0x5c, 0xa0, 0xba, 0x25, 0x89, 0x3c, 0xc6, 0xf7, 0xa2,
-	0x4c, 0xc4, 0x23, 0x15, 0x8e, 0xa9, 0x0c, 0x67, 0x14, 0xa4, 0x63, 0x5f, 0xa2, 0xeb, 0xc3, 0xe0,
-	0xc8, 0xc8, 0x03, 0x2a, 0x5f, 0x51, 0x90, 0x4f, 0xcf, 0x3f, 0x6e, 0xe7, 0x4d, 0xc7, 0x90, 0x5a,
-	0x2a, 0x19, 0xf9, 0x53, 0xf3, 0x20, 0xcc, 0x21, 0x77, 0x3b, 0x8b, 0xb5, 0x8b, 0x96, 0x6b, 0x17,
-	0xfd, 0x5c, 0xbb, 0xe8, 0xd3, 0xc6, 0xb5, 0x96, 0x1b, 0xd7, 0xfa, 0xbe, 0x71, 0xad, 0xd7, 0xa7,
-	0x7f, 0x84, 0xab, 0xba, 0xf4, 0x6c, 0x4c, 0x55, 0x64, 0x97, 0xf7, 0xbc, 0xf3, 0x3b, 0x00, 0x00,
-	0xff, 0xff, 0xcd, 0x30, 0x64, 0xe0, 0x62, 0x03, 0x00, 0x00,
+	// 589 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x95, 0xc1, 0x6f, 0x12, 0x4f,
+	0x14, 0xc7, 0x59, 0xa0, 0xfc, 0x7e, 0x4c, 0x4b, 0x2a, 0xd3, 0xda, 0x6c, 0x31, 0xdd, 0x12, 0x0e,
+	0x86, 0x34, 0x29, 0x1b, 0x52, 0xe3, 0xc1, 0x23, 0x72, 0xb0, 0x89, 0x8d, 0x64, 0x35, 0x31, 0x6a,
+	0xe2, 0x3a, 0xec, 0x8e, 0xcb, 0x08, 0x3b, 0x43, 0x66, 0x06, 0x02, 0xfc, 0x09, 0x9e, 0xfc, 0x0f,
+	0xbc, 0x7a, 0x24, 0xc6, 0x8b, 0xff, 0x41, 0x8f, 0x8d, 0x27, 0xe3, 0xa1, 0x31, 0x70, 0xe8, 0xbf,
+	0x61, 0x76, 0x67, 0xbb, 0x2b, 0x1a, 0x0f, 0x02, 0x5e, 0x08, 0xf3, 0xde, 0xcc, 0xe7, 0xfb, 0xe6,
+	0xbd, 0x37, 0x6f, 0x81, 0xe1, 0x30, 0xe1, 0x33, 0x61, 0xfa, 0x84, 0x4a, 0x73, 0x58, 0x6f, 0x63,
+	0x89, 0xea, 0xe1, 0xa2, 0xd6, 0xe7, 0x4c, 0x32, 0xb8, 0xa3, 0xfc, 0xb5, 0xd0, 0x14, 0xf9, 0x4b,
+	0xbb, 0x1e, 0xf3, 0x58, 0xe8, 0x37, 0x83, 0x7f, 0x6a, 0x6b, 0x69, 0x5f, 0x6d, 0xb5, 0x95, 0x23,
+	0x3a, 0xa7, 0x5c, 0x45, 0xe4, 0x13, 0xca, 0xcc, 0xf0, 0x57, 0x99, 0x2a, 0x9f, 0x35, 0x90, 0x3b,
+	0x23, 0x54, 0x62, 0x0e, 0x1f, 0x81, 0x3c, 0xa1, 0xaf, 0x7b, 0x48, 0x12, 0x46, 0x75, 0xad, 0xac,
+	0x55, 0xf3, 0x8d, 0xfa, 0xf9, 0xe5, 0x61, 0xea, 0xdb, 0xe5, 0xe1, 0x2d, 0x85, 0x11, 0x6e, 0xb7,
+	0x46, 0x98, 0xe9, 0x23, 0xd9, 0xa9, 0x3d, 0xc4, 0x1e, 0x72, 0xc6, 0x4d, 0xec, 0x7c, 0xf9, 0x74,
+	0x0c, 0x22, 0x95, 0x26, 0x76, 0xac, 0x84, 0x01, 0x5f, 0x82, 0x22, 0xa2, 0x74, 0x80, 0x7a, 0x41,
+	0x2c, 0x43, 0x22, 0x08, 0xa3, 0x42, 0x4f, 0x2f, 0x0b, 0xbe, 0xa1, 0x58, 0xad, 0x18, 0x55, 0x79,
+	0xbf, 0x01, 0x72, 0x2d, 0xc4, 0x91, 0x2f, 0xe0, 0x01, 0x00, 0x41, 0x6a, 0x6c, 0x17, 0x53, 0xe6,
+	0xab, 0xe0, 0xad, 0x7c, 0x60, 0x69, 0x06, 0x06, 0xf8, 0x06, 0xdc, 0x8c, 0xc3, 0xb2, 0x39, 0x92,
+	0xd8, 0x76, 0x3a, 0x88, 0x7a, 0x38, 0x8a, 0xe6, 0xee, 0x5f, 0x47, 0xf3, 0xe1, 0x6a, 0x7a, 0xa4,
+	0x59, 0x3b, 0x31, 0xd4, 0x42, 0x12, 0xdf, 0x0f, 0x91, 0xf0, 0x05, 0x28, 0x24, 0x5a, 0x3e, 0x1a,
+	0xe9, 0x99, 0x95, 0x34, 0xb6, 0x62, 0xd8, 0x19, 0x1a, 0xfd, 0x02, 0x27, 0x54, 0xcf, 0xae, 0x0b,
+	0x4e, 0x28, 0x7c, 0x0a, 0x36, 0x3d, 0x86, 0x7a, 0x76, 0x9b, 0x51, 0x17, 0xbb, 0xfa, 0xc6, 0x4a,
+	0x68, 0x10, 0xa0, 0x1a, 0x21, 0x09, 0xde, 0x06, 0xdb, 0xed, 0x1e, 0x73, 0xba, 0xc2, 0xee, 0x63,
+	0x6e, 0x8f, 0x31, 0xe2, 0x7a, 0xae, 0xac, 0x55, 0xb3, 0x56, 0x41, 0x99, 0x5b, 0x98, 0x3f, 0xc3,
+	0x88, 0xc3, 0x09, 0x30, 0x7c, 0x34, 0xb2, 0x93, 0x1b, 0xaa, 0x2a, 0x85, 0xa7, 0xc2, 0x9d, 0xfa,
+	0x7f, 0x2b, 0xc5, 0x54, 0xf2, 0xd1, 0xe8, 0xf4, 0x1a, 0xae, 0xca, 0xd5, 0xc2, 0xbc, 0x11, 0x90,
+	0xe1, 0x1d, 0xb0, 0x97, 0xe8, 0x76, 0x88, 0x90, 0x8c, 0x8f, 0x6d, 0x41, 0x26, 0x58, 0xff, 0xbf,
+	0xac, 0x55, 0x0b, 0xd6, 0x6e, 0xec, 0x7d, 0xa0, 0x9c, 0x8f, 0xc9, 0x04, 0xdf, 0x3b, 0x78, 0x7b,
+	0x35, 0x3d, 0xd2, 0x95, 0xce, 0xb1, 0x70, 0xbb, 0xe6, 0x48, 0x3d, 0x61, 0xd5, 0x96, 0x95, 0x8f,
+	0x69, 0xb0, 0x1d, 0x0b, 0x9e, 0xd2, 0xfe, 0x40, 0x0a, 0xb8, 0x07, 0x72, 0x1d, 0x4c, 0xbc, 0x8e,
+	0x0c, 0xdb, 0x34, 0x63, 0x45, 0x2b, 0xf8, 0x04, 0x6c, 0xa9, 0xc4, 0x07, 0x0d, 0x4a, 0xd8, 0xf2,
+	0x0f, 0x65, 0x53, 0x61, 0xac, 0x80, 0x02, 0x5f, 0x01, 0xd8, 0xe7, 0x78, 0x48, 0xd8, 0x40, 0x24,
+	0x79, 0xd5, 0x33, 0xcb, 0xb2, 0x8b, 0xd7, 0xb0, 0xf8, 0x56, 0x8b, 0x63, 0x23, 0xbb, 0xfa, 0xd8,
+	0xa8, 0x4c, 0x7f, 0x4e, 0x9a, 0x85, 0x1d, 0xc6, 0xdd, 0x3f, 0x26, 0x6d, 0x41, 0x3c, 0xfd, 0xaf,
+	0x66, 0x56, 0x66, 0x6d, 0x33, 0xeb, 0xb7, 0x2a, 0x67, 0xd7, 0x51, 0xe5, 0xc6, 0xc9, 0xf9, 0xcc,
+	0xd0, 0x2e, 0x66, 0x86, 0xf6, 0x7d, 0x66, 0x68, 0xef, 0xe6, 0x46, 0xea, 0x62, 0x6e, 0xa4, 0xbe,
+	0xce, 0x8d, 0xd4, 0xf3, 0xfd, 0x05, 0x62, 0xd4, 0x9d, 0x72, 0xdc, 0xc7, 0xa2, 0x9d, 0x0b, 0xbf,
+	0x00, 0x27, 0x3f, 0x06, 0x00, 0x42, 0x32, 0xcf, 0xcc, 0x7c, 0x06, 0x00, 0x00,
 }
 
 func (m *Minter) Marshal() (dAtA []byte, err error) {
@@ -231,6 +362,21 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.InflationHistorySize != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.InflationHistorySize))
+		i--
+		dAtA[i] = 0x40
+	}
+	{
+		size := m.MaxInflationChangePerBlock.Size()
+		i -= size
+		if _, err := m.MaxInflationChangePerBlock.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
 	if m.BlocksPerYear != 0 {
 		i = encodeVarintMint(dAtA, i, uint64(m.BlocksPerYear))
 		i--
@@ -286,6 +432,122 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *InflationInputs) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InflationInputs) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InflationInputs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Inflation.Size()
+		i -= size
+		if _, err := m.Inflation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.PreviousInflation.Size()
+		i -= size
+		if _, err := m.PreviousInflation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.BondedRatio.Size()
+		i -= size
+		if _, err := m.BondedRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Height != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InflationRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InflationRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InflationRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.BondedRatio.Size()
+		i -= size
+		if _, err := m.BondedRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.AnnualProvisions.Size()
+		i -= size
+		if _, err := m.AnnualProvisions.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Inflation.Size()
+		i -= size
+		if _, err := m.Inflation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Height != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintMint(dAtA []byte, offset int, v uint64) int {
 	offset -= sovMint(v)
 	base := offset
@@ -331,6 +593,47 @@ func (m *Params) Size() (n int) {
 	if m.BlocksPerYear != 0 {
 		n += 1 + sovMint(uint64(m.BlocksPerYear))
 	}
+	l = m.MaxInflationChangePerBlock.Size()
+	n += 1 + l + sovMint(uint64(l))
+	if m.InflationHistorySize != 0 {
+		n += 1 + sovMint(uint64(m.InflationHistorySize))
+	}
+	return n
+}
+
+func (m *InflationInputs) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovMint(uint64(m.Height))
+	}
+	l = m.BondedRatio.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.PreviousInflation.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.Inflation.Size()
+	n += 1 + l + sovMint(uint64(l))
+	return n
+}
+
+func (m *InflationRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovMint(uint64(m.Height))
+	}
+	l = m.Inflation.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.AnnualProvisions.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.BondedRatio.Size()
+	n += 1 + l + sovMint(uint64(l))
 	return n
 }
 
@@ -674,6 +977,401 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxInflationChangePerBlock", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxInflationChangePerBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationHistorySize", wireType)
+			}
+			m.InflationHistorySize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InflationHistorySize |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InflationInputs) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InflationInputs: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InflationInputs: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BondedRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BondedRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousInflation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PreviousInflation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inflation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Inflation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InflationRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InflationRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InflationRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inflation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Inflation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AnnualProvisions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AnnualProvisions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BondedRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BondedRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMint(dAtA[iNdEx:])
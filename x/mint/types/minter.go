@@ -68,6 +68,25 @@ func (m Minter) NextInflationRate(params Params, bondedRatio math.LegacyDec) mat
 	return inflation
 }
 
+// ClampInflationChange limits the change from the minter's inflation rate to
+// the given next inflation rate to params.MaxInflationChangePerBlock. The next
+// inflation rate is returned unchanged if the limit is unset or zero.
+func (m Minter) ClampInflationChange(params Params, inflation math.LegacyDec) math.LegacyDec {
+	maxChange := params.MaxInflationChangePerBlock
+	if maxChange.IsNil() || !maxChange.IsPositive() {
+		return inflation
+	}
+
+	if upper := m.Inflation.Add(maxChange); inflation.GT(upper) {
+		return upper
+	}
+	if lower := m.Inflation.Sub(maxChange); inflation.LT(lower) {
+		return lower
+	}
+
+	return inflation
+}
+
 // NextAnnualProvisions returns the annual provisions based on current total
 // supply and inflation rate.
 func (m Minter) NextAnnualProvisions(_ Params, totalSupply math.Int) math.LegacyDec {
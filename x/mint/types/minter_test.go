@@ -60,6 +60,29 @@ func TestNextInflation(t *testing.T) {
 	}
 }
 
+func TestClampInflationChange(t *testing.T) {
+	minter := InitialMinter(math.LegacyNewDecWithPrec(10, 2))
+	params := DefaultParams()
+	params.MaxInflationChangePerBlock = math.LegacyNewDecWithPrec(1, 2)
+
+	tests := []struct {
+		name                    string
+		maxChange, next, expInf math.LegacyDec
+	}{
+		{"within limit", params.MaxInflationChangePerBlock, math.LegacyNewDecWithPrec(105, 3), math.LegacyNewDecWithPrec(105, 3)},
+		{"increase clamped", params.MaxInflationChangePerBlock, math.LegacyNewDecWithPrec(20, 2), math.LegacyNewDecWithPrec(11, 2)},
+		{"decrease clamped", params.MaxInflationChangePerBlock, math.LegacyNewDecWithPrec(7, 2), math.LegacyNewDecWithPrec(9, 2)},
+		{"zero limit", math.LegacyZeroDec(), math.LegacyNewDecWithPrec(20, 2), math.LegacyNewDecWithPrec(20, 2)},
+		{"unset limit", math.LegacyDec{}, math.LegacyNewDecWithPrec(7, 2), math.LegacyNewDecWithPrec(7, 2)},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			params.MaxInflationChangePerBlock = tc.maxChange
+			require.Equal(t, tc.expInf, minter.ClampInflationChange(params, tc.next))
+		})
+	}
+}
+
 func TestBlockProvision(t *testing.T) {
 	minter := InitialMinter(math.LegacyNewDecWithPrec(1, 1))
 	params := DefaultParams()
@@ -10,6 +10,15 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+const (
+	// DefaultInflationHistorySize is the default number of blocks whose
+	// inflation is kept for the InflationHistory query.
+	DefaultInflationHistorySize uint32 = 10
+	// MaxInflationHistorySize is the maximum number of blocks whose inflation
+	// can be kept for the InflationHistory query.
+	MaxInflationHistorySize uint32 = 1000
+)
+
 // NewParams returns Params instance with the given values.
 func NewParams(mintDenom string, inflationRateChange, inflationMax, inflationMin, goalBonded math.LegacyDec, blocksPerYear uint64) Params {
 	return Params{
@@ -19,18 +28,23 @@ func NewParams(mintDenom string, inflationRateChange, inflationMax, inflationMin
 		InflationMin:        inflationMin,
 		GoalBonded:          goalBonded,
 		BlocksPerYear:       blocksPerYear,
+		// the change of inflation between blocks is not limited by default
+		MaxInflationChangePerBlock: math.LegacyZeroDec(),
+		InflationHistorySize:       DefaultInflationHistorySize,
 	}
 }
 
 // DefaultParams returns default x/mint module parameters.
 func DefaultParams() Params {
 	return Params{
-		MintDenom:           sdk.DefaultBondDenom,
-		InflationRateChange: math.LegacyNewDecWithPrec(13, 2),
-		InflationMax:        math.LegacyNewDecWithPrec(20, 2),
-		InflationMin:        math.LegacyNewDecWithPrec(7, 2),
-		GoalBonded:          math.LegacyNewDecWithPrec(67, 2),
-		BlocksPerYear:       uint64(60 * 60 * 8766 / 5), // assuming 5 second block times
+		MintDenom:                  sdk.DefaultBondDenom,
+		InflationRateChange:        math.LegacyNewDecWithPrec(13, 2),
+		InflationMax:               math.LegacyNewDecWithPrec(20, 2),
+		InflationMin:               math.LegacyNewDecWithPrec(7, 2),
+		GoalBonded:                 math.LegacyNewDecWithPrec(67, 2),
+		BlocksPerYear:              uint64(60 * 60 * 8766 / 5), // assuming 5 second block times
+		MaxInflationChangePerBlock: math.LegacyZeroDec(),
+		InflationHistorySize:       DefaultInflationHistorySize,
 	}
 }
 
@@ -54,6 +68,12 @@ func (p Params) Validate() error {
 	if err := validateBlocksPerYear(p.BlocksPerYear); err != nil {
 		return err
 	}
+	if err := validateMaxInflationChangePerBlock(p.MaxInflationChangePerBlock); err != nil {
+		return err
+	}
+	if err := validateInflationHistorySize(p.InflationHistorySize); err != nil {
+		return err
+	}
 	if p.InflationMax.LT(p.InflationMin) {
 		return fmt.Errorf(
 			"max inflation (%s) must be greater than or equal to min inflation (%s)",
@@ -168,3 +188,37 @@ func validateBlocksPerYear(i interface{}) error {
 
 	return nil
 }
+
+func validateMaxInflationChangePerBlock(i interface{}) error {
+	v, ok := i.(math.LegacyDec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	// params set before the introduction of the limit leave it unset, which
+	// is equivalent to zero
+	if v.IsNil() {
+		return nil
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("max inflation change per block cannot be negative: %s", v)
+	}
+	if v.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("max inflation change per block too large: %s", v)
+	}
+
+	return nil
+}
+
+func validateInflationHistorySize(i interface{}) error {
+	v, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v > MaxInflationHistorySize {
+		return fmt.Errorf("inflation history size too large: %d > %d", v, MaxInflationHistorySize)
+	}
+
+	return nil
+}
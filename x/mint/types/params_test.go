@@ -0,0 +1,49 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+)
+
+func TestParamsValidate(t *testing.T) {
+	testCases := []struct {
+		name     string
+		malleate func(p *Params)
+		expErr   string
+	}{
+		{"default params", func(p *Params) {}, ""},
+		{"min equals max inflation", func(p *Params) { p.InflationMin = p.InflationMax }, ""},
+		{"blank mint denom", func(p *Params) { p.MintDenom = "" }, "mint denom cannot be blank"},
+		{"negative inflation rate change", func(p *Params) { p.InflationRateChange = math.LegacyNewDec(-1) }, "inflation rate change cannot be negative"},
+		{"inflation rate change above one", func(p *Params) { p.InflationRateChange = math.LegacyNewDec(2) }, "inflation rate change too large"},
+		{"negative max inflation", func(p *Params) { p.InflationMax = math.LegacyNewDec(-1) }, "max inflation cannot be negative"},
+		{"max inflation above one", func(p *Params) { p.InflationMax = math.LegacyNewDec(2) }, "max inflation too large"},
+		{"negative min inflation", func(p *Params) { p.InflationMin = math.LegacyNewDec(-1) }, "min inflation cannot be negative"},
+		{"min inflation above max", func(p *Params) { p.InflationMin = math.LegacyNewDecWithPrec(30, 2) }, "max inflation (0.200000000000000000) must be greater than or equal to min inflation (0.300000000000000000)"},
+		{"zero goal bonded", func(p *Params) { p.GoalBonded = math.LegacyZeroDec() }, "goal bonded must be positive"},
+		{"goal bonded above one", func(p *Params) { p.GoalBonded = math.LegacyNewDec(2) }, "goal bonded too large"},
+		{"zero blocks per year", func(p *Params) { p.BlocksPerYear = 0 }, "blocks per year must be positive"},
+		{"unset max inflation change per block", func(p *Params) { p.MaxInflationChangePerBlock = math.LegacyDec{} }, ""},
+		{"negative max inflation change per block", func(p *Params) { p.MaxInflationChangePerBlock = math.LegacyNewDecWithPrec(-1, 2) }, "max inflation change per block cannot be negative"},
+		{"zero inflation history size", func(p *Params) { p.InflationHistorySize = 0 }, ""},
+		{"inflation history size too large", func(p *Params) { p.InflationHistorySize = MaxInflationHistorySize + 1 }, "inflation history size too large"},
+		{"max inflation change per block above one", func(p *Params) { p.MaxInflationChangePerBlock = math.LegacyNewDec(2) }, "max inflation change per block too large"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			params := DefaultParams()
+			tc.malleate(&params)
+
+			err := params.Validate()
+			if tc.expErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorContains(t, err, tc.expErr)
+		})
+	}
+}
@@ -270,6 +270,259 @@ func (m *QueryAnnualProvisionsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryAnnualProvisionsResponse proto.InternalMessageInfo
 
+// QueryLastInflationInputsRequest is the request type for the
+// Query/LastInflationInputs RPC method.
+type QueryLastInflationInputsRequest struct {
+}
+
+func (m *QueryLastInflationInputsRequest) Reset()         { *m = QueryLastInflationInputsRequest{} }
+func (m *QueryLastInflationInputsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryLastInflationInputsRequest) ProtoMessage()    {}
+func (*QueryLastInflationInputsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d0a1e393be338aea, []int{6}
+}
+func (m *QueryLastInflationInputsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLastInflationInputsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLastInflationInputsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryLastInflationInputsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLastInflationInputsRequest.Merge(m, src)
+}
+func (m *QueryLastInflationInputsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLastInflationInputsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLastInflationInputsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryLastInflationInputsRequest proto.InternalMessageInfo
+
+// QueryLastInflationInputsResponse is the response type for the
+// Query/LastInflationInputs RPC method.
+type QueryLastInflationInputsResponse struct {
+	// inputs defines the inputs used by the last inflation computation.
+	Inputs InflationInputs `protobuf:"bytes,1,opt,name=inputs,proto3" json:"inputs"`
+}
+
+func (m *QueryLastInflationInputsResponse) Reset()         { *m = QueryLastInflationInputsResponse{} }
+func (m *QueryLastInflationInputsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryLastInflationInputsResponse) ProtoMessage()    {}
+func (*QueryLastInflationInputsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d0a1e393be338aea, []int{7}
+}
+func (m *QueryLastInflationInputsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLastInflationInputsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLastInflationInputsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryLastInflationInputsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLastInflationInputsResponse.Merge(m, src)
+}
+func (m *QueryLastInflationInputsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLastInflationInputsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLastInflationInputsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryLastInflationInputsResponse proto.InternalMessageInfo
+
+func (m *QueryLastInflationInputsResponse) GetInputs() InflationInputs {
+	if m != nil {
+		return m.Inputs
+	}
+	return InflationInputs{}
+}
+
+// QueryInflationHistoryRequest is the request type for the
+// Query/InflationHistory RPC method.
+type QueryInflationHistoryRequest struct {
+}
+
+func (m *QueryInflationHistoryRequest) Reset()         { *m = QueryInflationHistoryRequest{} }
+func (m *QueryInflationHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryInflationHistoryRequest) ProtoMessage()    {}
+func (*QueryInflationHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d0a1e393be338aea, []int{8}
+}
+func (m *QueryInflationHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInflationHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInflationHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryInflationHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInflationHistoryRequest.Merge(m, src)
+}
+func (m *QueryInflationHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInflationHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInflationHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryInflationHistoryRequest proto.InternalMessageInfo
+
+// QueryInflationHistoryResponse is the response type for the
+// Query/InflationHistory RPC method.
+type QueryInflationHistoryResponse struct {
+	// records defines the minter state of the last blocks, from the oldest to
+	// the most recent.
+	Records []InflationRecord `protobuf:"bytes,1,rep,name=records,proto3" json:"records"`
+}
+
+func (m *QueryInflationHistoryResponse) Reset()         { *m = QueryInflationHistoryResponse{} }
+func (m *QueryInflationHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryInflationHistoryResponse) ProtoMessage()    {}
+func (*QueryInflationHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d0a1e393be338aea, []int{9}
+}
+func (m *QueryInflationHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInflationHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInflationHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryInflationHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInflationHistoryResponse.Merge(m, src)
+}
+func (m *QueryInflationHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInflationHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInflationHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryInflationHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryInflationHistoryResponse) GetRecords() []InflationRecord {
+	if m != nil {
+		return m.Records
+	}
+	return nil
+}
+
+// QueryProjectedProvisionsRequest is the request type for the
+// Query/ProjectedProvisions RPC method.
+type QueryProjectedProvisionsRequest struct {
+	// bonded_ratio is the hypothetical bonded ratio, between 0 and 1.
+	BondedRatio cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=bonded_ratio,json=bondedRatio,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"bonded_ratio"`
+}
+
+func (m *QueryProjectedProvisionsRequest) Reset()         { *m = QueryProjectedProvisionsRequest{} }
+func (m *QueryProjectedProvisionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProjectedProvisionsRequest) ProtoMessage()    {}
+func (*QueryProjectedProvisionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d0a1e393be338aea, []int{10}
+}
+func (m *QueryProjectedProvisionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProjectedProvisionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProjectedProvisionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProjectedProvisionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProjectedProvisionsRequest.Merge(m, src)
+}
+func (m *QueryProjectedProvisionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProjectedProvisionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProjectedProvisionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProjectedProvisionsRequest proto.InternalMessageInfo
+
+// QueryProjectedProvisionsResponse is the response type for the
+// Query/ProjectedProvisions RPC method.
+type QueryProjectedProvisionsResponse struct {
+	// inflation is the projected inflation rate.
+	Inflation cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=inflation,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"inflation"`
+	// annual_provisions is the projected annual provisions.
+	AnnualProvisions cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=annual_provisions,json=annualProvisions,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"annual_provisions"`
+}
+
+func (m *QueryProjectedProvisionsResponse) Reset()         { *m = QueryProjectedProvisionsResponse{} }
+func (m *QueryProjectedProvisionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProjectedProvisionsResponse) ProtoMessage()    {}
+func (*QueryProjectedProvisionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d0a1e393be338aea, []int{11}
+}
+func (m *QueryProjectedProvisionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProjectedProvisionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProjectedProvisionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProjectedProvisionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProjectedProvisionsResponse.Merge(m, src)
+}
+func (m *QueryProjectedProvisionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProjectedProvisionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProjectedProvisionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProjectedProvisionsResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.mint.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.mint.v1beta1.QueryParamsResponse")
@@ -277,42 +530,63 @@ func init() {
 	proto.RegisterType((*QueryInflationResponse)(nil), "cosmos.mint.v1beta1.QueryInflationResponse")
 	proto.RegisterType((*QueryAnnualProvisionsRequest)(nil), "cosmos.mint.v1beta1.QueryAnnualProvisionsRequest")
 	proto.RegisterType((*QueryAnnualProvisionsResponse)(nil), "cosmos.mint.v1beta1.QueryAnnualProvisionsResponse")
+	proto.RegisterType((*QueryLastInflationInputsRequest)(nil), "cosmos.mint.v1beta1.QueryLastInflationInputsRequest")
+	proto.RegisterType((*QueryLastInflationInputsResponse)(nil), "cosmos.mint.v1beta1.QueryLastInflationInputsResponse")
+	proto.RegisterType((*QueryInflationHistoryRequest)(nil), "cosmos.mint.v1beta1.QueryInflationHistoryRequest")
+	proto.RegisterType((*QueryInflationHistoryResponse)(nil), "cosmos.mint.v1beta1.QueryInflationHistoryResponse")
+	proto.RegisterType((*QueryProjectedProvisionsRequest)(nil), "cosmos.mint.v1beta1.QueryProjectedProvisionsRequest")
+	proto.RegisterType((*QueryProjectedProvisionsResponse)(nil), "cosmos.mint.v1beta1.QueryProjectedProvisionsResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/mint/v1beta1/query.proto", fileDescriptor_d0a1e393be338aea) }
 
 var fileDescriptor_d0a1e393be338aea = []byte{
-	// 480 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x93, 0x4d, 0x8b, 0xd3, 0x40,
-	0x18, 0xc7, 0x33, 0x8a, 0x85, 0x8e, 0x1e, 0x76, 0x67, 0xd7, 0x97, 0x4d, 0x77, 0xa7, 0x4b, 0x84,
-	0xb5, 0xac, 0x38, 0x43, 0xbb, 0xe0, 0x51, 0xb0, 0xec, 0x45, 0xf0, 0xb0, 0x2e, 0x7a, 0xf1, 0x22,
-	0xb3, 0x71, 0x8c, 0xc1, 0x66, 0x26, 0x9b, 0x99, 0x16, 0x7b, 0x13, 0xf1, 0xe8, 0x41, 0xf0, 0x4b,
-	0xe8, 0xcd, 0x83, 0x5f, 0x41, 0xe8, 0xb1, 0xe8, 0x45, 0x3c, 0x14, 0x69, 0x05, 0xbf, 0x86, 0x64,
-	0x66, 0x5a, 0x69, 0x9a, 0xa0, 0xe2, 0x25, 0x24, 0xcf, 0xdb, 0xff, 0x37, 0xcf, 0x7f, 0x02, 0x9b,
-	0xa1, 0x54, 0x89, 0x54, 0x34, 0x89, 0x85, 0xa6, 0x83, 0xf6, 0x09, 0xd7, 0xac, 0x4d, 0x4f, 0xfb,
-	0x3c, 0x1b, 0x92, 0x34, 0x93, 0x5a, 0xa2, 0x0d, 0x5b, 0x40, 0xf2, 0x02, 0xe2, 0x0a, 0xfc, 0xcd,
-	0x48, 0x46, 0xd2, 0xe4, 0x69, 0xfe, 0x66, 0x4b, 0xfd, 0xed, 0x48, 0xca, 0xa8, 0xc7, 0x29, 0x4b,
-	0x63, 0xca, 0x84, 0x90, 0x9a, 0xe9, 0x58, 0x0a, 0xe5, 0xb2, 0xb8, 0x4c, 0xc9, 0x4c, 0xb5, 0xf9,
-	0x75, 0x96, 0xc4, 0x42, 0x52, 0xf3, 0x74, 0xa1, 0x2d, 0xdb, 0xf2, 0xc8, 0x2a, 0x39, 0x10, 0xf3,
-	0x11, 0x6c, 0x42, 0x74, 0x2f, 0xa7, 0x3c, 0x62, 0x19, 0x4b, 0xd4, 0x31, 0x3f, 0xed, 0x73, 0xa5,
-	0x83, 0x07, 0x70, 0x63, 0x29, 0xaa, 0x52, 0x29, 0x14, 0x47, 0xb7, 0x60, 0x2d, 0x35, 0x91, 0x2b,
-	0x60, 0x17, 0xb4, 0xce, 0x77, 0x1a, 0xa4, 0xe4, 0x50, 0xc4, 0x36, 0x75, 0xeb, 0xa3, 0x49, 0xd3,
-	0x7b, 0xf7, 0xf3, 0xc3, 0x3e, 0x38, 0x76, 0x5d, 0xc1, 0x65, 0x78, 0xd1, 0x8c, 0xbd, 0x23, 0x9e,
-	0xf4, 0xcc, 0x99, 0xe6, 0x7a, 0x02, 0x5e, 0x2a, 0x26, 0x9c, 0xe4, 0x7d, 0x58, 0x8f, 0xe7, 0x41,
-	0xa3, 0x7a, 0xa1, 0x7b, 0x33, 0x1f, 0xfc, 0x6d, 0xd2, 0x6c, 0x58, 0x71, 0xf5, 0xf8, 0x19, 0x89,
-	0x25, 0x4d, 0x98, 0x7e, 0x4a, 0xee, 0xf2, 0x88, 0x85, 0xc3, 0x43, 0x1e, 0x7e, 0xfe, 0x78, 0x03,
-	0x3a, 0xb6, 0x43, 0x1e, 0x5a, 0x8a, 0xdf, 0x83, 0x02, 0x0c, 0xb7, 0x8d, 0xde, 0x6d, 0x21, 0xfa,
-	0xac, 0x77, 0x94, 0xc9, 0x41, 0xac, 0xf2, 0x15, 0xcf, 0x79, 0x5e, 0x01, 0xb8, 0x53, 0x51, 0xe0,
-	0xb8, 0x42, 0xb8, 0xce, 0x4c, 0x2e, 0x5f, 0xaa, 0x4b, 0xfe, 0x27, 0xdf, 0x1a, 0x2b, 0x88, 0x75,
-	0x3e, 0x9d, 0x85, 0xe7, 0x0c, 0x06, 0x7a, 0x01, 0x60, 0xcd, 0xee, 0x15, 0x5d, 0x2b, 0x5d, 0xfa,
-	0xaa, 0x89, 0x7e, 0xeb, 0xcf, 0x85, 0xf6, 0x30, 0xc1, 0xd5, 0x97, 0x5f, 0x7e, 0xbc, 0x3d, 0xb3,
-	0x83, 0x1a, 0xb4, 0xec, 0x6e, 0x59, 0xf3, 0xd0, 0x6b, 0x00, 0xeb, 0x0b, 0x7f, 0xd0, 0x7e, 0xf5,
-	0xf0, 0xa2, 0xbb, 0xfe, 0xf5, 0xbf, 0xaa, 0x75, 0x2c, 0x7b, 0x86, 0x65, 0x17, 0xe1, 0x52, 0x96,
-	0x85, 0x85, 0xe8, 0x3d, 0x80, 0x6b, 0x45, 0x77, 0x50, 0xbb, 0x5a, 0xa9, 0xc2, 0x6a, 0xbf, 0xf3,
-	0x2f, 0x2d, 0x8e, 0x91, 0x18, 0xc6, 0x16, 0xda, 0x2b, 0x65, 0x5c, 0xb9, 0x17, 0xdd, 0x83, 0xd1,
-	0x14, 0x83, 0xf1, 0x14, 0x83, 0xef, 0x53, 0x0c, 0xde, 0xcc, 0xb0, 0x37, 0x9e, 0x61, 0xef, 0xeb,
-	0x0c, 0x7b, 0x0f, 0xb7, 0x96, 0xee, 0xc8, 0x73, 0x3b, 0x48, 0x0f, 0x53, 0xae, 0x4e, 0x6a, 0xe6,
-	0x07, 0x3d, 0xf8, 0x15, 0x00, 0x00, 0xff, 0xff, 0x8c, 0x7d, 0x50, 0x82, 0x5a, 0x04, 0x00, 0x00,
+	// 712 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x96, 0x4d, 0x4f, 0x13, 0x4f,
+	0x1c, 0xc7, 0x3b, 0xfc, 0xf3, 0x2f, 0xe9, 0xc0, 0x01, 0xa6, 0xf8, 0xc0, 0x02, 0xdb, 0xba, 0x1a,
+	0x44, 0xd0, 0xdd, 0xb4, 0x88, 0x47, 0x13, 0x1b, 0x12, 0x25, 0x21, 0x11, 0x1b, 0xbc, 0x78, 0xb0,
+	0x19, 0xb6, 0x63, 0x59, 0x68, 0x77, 0x96, 0x9d, 0x29, 0xda, 0x9b, 0x31, 0x1e, 0x3d, 0x98, 0xf8,
+	0x26, 0xf4, 0xe6, 0x41, 0x7d, 0x0d, 0x1c, 0x89, 0x5c, 0x8c, 0x07, 0x62, 0x8a, 0x89, 0x6f, 0xc3,
+	0xec, 0xcc, 0xec, 0x16, 0xb6, 0xbb, 0x40, 0xf1, 0xd2, 0xb4, 0xf3, 0x7b, 0xf8, 0x7e, 0xe6, 0x37,
+	0x33, 0xdf, 0x14, 0x16, 0x6c, 0xca, 0x5a, 0x94, 0x59, 0x2d, 0xc7, 0xe5, 0xd6, 0x6e, 0x69, 0x83,
+	0x70, 0x5c, 0xb2, 0x76, 0xda, 0xc4, 0xef, 0x98, 0x9e, 0x4f, 0x39, 0x45, 0x79, 0x99, 0x60, 0x06,
+	0x09, 0xa6, 0x4a, 0xd0, 0x26, 0x1a, 0xb4, 0x41, 0x45, 0xdc, 0x0a, 0xbe, 0xc9, 0x54, 0x6d, 0xba,
+	0x41, 0x69, 0xa3, 0x49, 0x2c, 0xec, 0x39, 0x16, 0x76, 0x5d, 0xca, 0x31, 0x77, 0xa8, 0xcb, 0x54,
+	0x54, 0x4f, 0x52, 0x12, 0x5d, 0x65, 0x7c, 0x1c, 0xb7, 0x1c, 0x97, 0x5a, 0xe2, 0x53, 0x2d, 0x4d,
+	0xca, 0x92, 0x9a, 0x54, 0x52, 0x20, 0xe2, 0x87, 0x31, 0x01, 0xd1, 0x93, 0x80, 0x72, 0x0d, 0xfb,
+	0xb8, 0xc5, 0xaa, 0x64, 0xa7, 0x4d, 0x18, 0x37, 0x9e, 0xc2, 0xfc, 0x89, 0x55, 0xe6, 0x51, 0x97,
+	0x11, 0x74, 0x1f, 0x66, 0x3d, 0xb1, 0x72, 0x15, 0x14, 0xc1, 0xdc, 0x48, 0x79, 0xca, 0x4c, 0xd8,
+	0x94, 0x29, 0x8b, 0x2a, 0xb9, 0xbd, 0xc3, 0x42, 0xe6, 0xe3, 0x9f, 0xcf, 0xf3, 0xa0, 0xaa, 0xaa,
+	0x8c, 0x2b, 0xf0, 0x92, 0x68, 0xbb, 0xe2, 0xbe, 0x68, 0x8a, 0x3d, 0x85, 0x7a, 0x2e, 0xbc, 0x1c,
+	0x0f, 0x28, 0xc9, 0x75, 0x98, 0x73, 0xc2, 0x45, 0xa1, 0x3a, 0x5a, 0xb9, 0x17, 0x34, 0xfe, 0x79,
+	0x58, 0x98, 0x92, 0xe2, 0xac, 0xbe, 0x6d, 0x3a, 0xd4, 0x6a, 0x61, 0xbe, 0x69, 0xae, 0x92, 0x06,
+	0xb6, 0x3b, 0xcb, 0xc4, 0xfe, 0xfe, 0xe5, 0x0e, 0x54, 0x6c, 0xcb, 0xc4, 0x96, 0x14, 0xbd, 0x46,
+	0x86, 0x0e, 0xa7, 0x85, 0xde, 0x03, 0xd7, 0x6d, 0xe3, 0xe6, 0x9a, 0x4f, 0x77, 0x1d, 0x16, 0x8c,
+	0x38, 0xe4, 0x79, 0x0b, 0xe0, 0x4c, 0x4a, 0x82, 0xe2, 0xb2, 0xe1, 0x38, 0x16, 0xb1, 0x9a, 0x17,
+	0x05, 0xff, 0x91, 0x6f, 0x0c, 0xc7, 0xc4, 0x8c, 0x6b, 0xb0, 0x20, 0x28, 0x56, 0x31, 0xe3, 0xd1,
+	0x68, 0x56, 0x5c, 0xaf, 0xcd, 0x23, 0xd2, 0x6d, 0x58, 0x4c, 0x4f, 0x51, 0xac, 0x0f, 0x61, 0xd6,
+	0x11, 0x2b, 0xea, 0xd8, 0x6e, 0x24, 0x1e, 0x5b, 0xac, 0xfa, 0xc4, 0xf9, 0xc9, 0xf2, 0x68, 0x6c,
+	0x51, 0xea, 0x23, 0x87, 0x71, 0xea, 0x77, 0x42, 0x98, 0x2d, 0x38, 0x93, 0x12, 0x57, 0x24, 0x2b,
+	0x70, 0xd8, 0x27, 0x36, 0xf5, 0xeb, 0x01, 0xca, 0x7f, 0x67, 0xa3, 0x54, 0x45, 0xf2, 0x71, 0x94,
+	0xb0, 0xde, 0x78, 0xa9, 0x66, 0xb3, 0xe6, 0xd3, 0x2d, 0x62, 0x73, 0x52, 0xef, 0x3b, 0x45, 0xb4,
+	0x0e, 0x47, 0x37, 0xa8, 0x5b, 0x27, 0xf5, 0x9a, 0x1f, 0x74, 0x13, 0xbb, 0xcf, 0x55, 0x4a, 0x03,
+	0x1f, 0x4f, 0x75, 0x44, 0xb6, 0xa9, 0x06, 0x5d, 0x8c, 0x03, 0x00, 0x8b, 0xe9, 0xca, 0x6a, 0xa3,
+	0x8f, 0xe3, 0xd7, 0xf6, 0x42, 0xba, 0xbd, 0x1e, 0xe8, 0x79, 0xd2, 0x7d, 0x1b, 0xba, 0x68, 0xe3,
+	0xbe, 0xab, 0x56, 0xee, 0x0e, 0xc3, 0xff, 0xc5, 0xae, 0xd0, 0x6b, 0x00, 0xb3, 0xf2, 0x09, 0xa3,
+	0x9b, 0x89, 0xa7, 0xd3, 0xef, 0x17, 0xda, 0xdc, 0xd9, 0x89, 0x72, 0x30, 0xc6, 0xf5, 0x37, 0x07,
+	0xbf, 0x3f, 0x0c, 0xcd, 0xa0, 0x29, 0x2b, 0xc9, 0xc6, 0xa4, 0x4f, 0xa0, 0x77, 0x00, 0xe6, 0xa2,
+	0x3b, 0x80, 0xe6, 0xd3, 0x9b, 0xc7, 0x8d, 0x44, 0x5b, 0x38, 0x57, 0xae, 0x62, 0x99, 0x15, 0x2c,
+	0x45, 0xa4, 0x27, 0xb2, 0xf4, 0x66, 0xff, 0x09, 0xc0, 0xb1, 0xb8, 0x11, 0xa0, 0x52, 0xba, 0x52,
+	0x8a, 0xab, 0x68, 0xe5, 0x41, 0x4a, 0x14, 0xa3, 0x29, 0x18, 0xe7, 0xd0, 0x6c, 0x22, 0x63, 0xdf,
+	0x95, 0x40, 0xdf, 0x00, 0xcc, 0x27, 0x78, 0x01, 0xba, 0x9b, 0xae, 0x9d, 0xee, 0x2e, 0xda, 0xd2,
+	0x80, 0x55, 0x0a, 0xba, 0x2c, 0xa0, 0x6f, 0xa3, 0xf9, 0x44, 0xe8, 0x26, 0x66, 0xbc, 0x16, 0x4d,
+	0xb7, 0x26, 0xbd, 0x45, 0x0c, 0x39, 0xee, 0x1b, 0xa7, 0x0d, 0x39, 0xc5, 0x83, 0xb4, 0xf2, 0x20,
+	0x25, 0xe7, 0x1a, 0x72, 0x0f, 0x75, 0x53, 0x61, 0x7d, 0x05, 0x30, 0x9f, 0xf0, 0xfa, 0x4f, 0x1b,
+	0x72, 0xba, 0x4d, 0x69, 0x4b, 0x03, 0x56, 0x29, 0xe8, 0x92, 0x80, 0x5e, 0x40, 0xb7, 0x92, 0x5f,
+	0x52, 0x58, 0x79, 0xec, 0x72, 0x54, 0x16, 0xf7, 0xba, 0x3a, 0xd8, 0xef, 0xea, 0xe0, 0x57, 0x57,
+	0x07, 0xef, 0x8f, 0xf4, 0xcc, 0xfe, 0x91, 0x9e, 0xf9, 0x71, 0xa4, 0x67, 0x9e, 0x4d, 0x9e, 0xf0,
+	0x8e, 0x57, 0xb2, 0x17, 0xef, 0x78, 0x84, 0x6d, 0x64, 0xc5, 0x1f, 0x85, 0xc5, 0xbf, 0x03, 0x00,
+	0x2b, 0x1d, 0x38, 0xe4, 0xe2, 0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -333,6 +607,16 @@ type QueryClient interface {
 	Inflation(ctx context.Context, in *QueryInflationRequest, opts ...grpc.CallOption) (*QueryInflationResponse, error)
 	// AnnualProvisions current minting annual provisions value.
 	AnnualProvisions(ctx context.Context, in *QueryAnnualProvisionsRequest, opts ...grpc.CallOption) (*QueryAnnualProvisionsResponse, error)
+	// LastInflationInputs returns the inputs used by the last inflation
+	// computation.
+	LastInflationInputs(ctx context.Context, in *QueryLastInflationInputsRequest, opts ...grpc.CallOption) (*QueryLastInflationInputsResponse, error)
+	// InflationHistory returns the minter state of the last blocks, as kept
+	// according to the inflation_history_size param.
+	InflationHistory(ctx context.Context, in *QueryInflationHistoryRequest, opts ...grpc.CallOption) (*QueryInflationHistoryResponse, error)
+	// ProjectedProvisions returns the inflation and annual provisions the next
+	// block would have for a hypothetical bonded ratio, given the current minter
+	// state, params and staking token supply.
+	ProjectedProvisions(ctx context.Context, in *QueryProjectedProvisionsRequest, opts ...grpc.CallOption) (*QueryProjectedProvisionsResponse, error)
 }
 
 type queryClient struct {
@@ -370,6 +654,33 @@ func (c *queryClient) AnnualProvisions(ctx context.Context, in *QueryAnnualProvi
 	return out, nil
 }
 
+func (c *queryClient) LastInflationInputs(ctx context.Context, in *QueryLastInflationInputsRequest, opts ...grpc.CallOption) (*QueryLastInflationInputsResponse, error) {
+	out := new(QueryLastInflationInputsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.mint.v1beta1.Query/LastInflationInputs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) InflationHistory(ctx context.Context, in *QueryInflationHistoryRequest, opts ...grpc.CallOption) (*QueryInflationHistoryResponse, error) {
+	out := new(QueryInflationHistoryResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.mint.v1beta1.Query/InflationHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ProjectedProvisions(ctx context.Context, in *QueryProjectedProvisionsRequest, opts ...grpc.CallOption) (*QueryProjectedProvisionsResponse, error) {
+	out := new(QueryProjectedProvisionsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.mint.v1beta1.Query/ProjectedProvisions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params returns the total set of minting parameters.
@@ -378,6 +689,16 @@ type QueryServer interface {
 	Inflation(context.Context, *QueryInflationRequest) (*QueryInflationResponse, error)
 	// AnnualProvisions current minting annual provisions value.
 	AnnualProvisions(context.Context, *QueryAnnualProvisionsRequest) (*QueryAnnualProvisionsResponse, error)
+	// LastInflationInputs returns the inputs used by the last inflation
+	// computation.
+	LastInflationInputs(context.Context, *QueryLastInflationInputsRequest) (*QueryLastInflationInputsResponse, error)
+	// InflationHistory returns the minter state of the last blocks, as kept
+	// according to the inflation_history_size param.
+	InflationHistory(context.Context, *QueryInflationHistoryRequest) (*QueryInflationHistoryResponse, error)
+	// ProjectedProvisions returns the inflation and annual provisions the next
+	// block would have for a hypothetical bonded ratio, given the current minter
+	// state, params and staking token supply.
+	ProjectedProvisions(context.Context, *QueryProjectedProvisionsRequest) (*QueryProjectedProvisionsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -393,6 +714,15 @@ func (*UnimplementedQueryServer) Inflation(ctx context.Context, req *QueryInflat
 func (*UnimplementedQueryServer) AnnualProvisions(ctx context.Context, req *QueryAnnualProvisionsRequest) (*QueryAnnualProvisionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AnnualProvisions not implemented")
 }
+func (*UnimplementedQueryServer) LastInflationInputs(ctx context.Context, req *QueryLastInflationInputsRequest) (*QueryLastInflationInputsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LastInflationInputs not implemented")
+}
+func (*UnimplementedQueryServer) InflationHistory(ctx context.Context, req *QueryInflationHistoryRequest) (*QueryInflationHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InflationHistory not implemented")
+}
+func (*UnimplementedQueryServer) ProjectedProvisions(ctx context.Context, req *QueryProjectedProvisionsRequest) (*QueryProjectedProvisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProjectedProvisions not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -452,6 +782,60 @@ func _Query_AnnualProvisions_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_LastInflationInputs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLastInflationInputsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LastInflationInputs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.mint.v1beta1.Query/LastInflationInputs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LastInflationInputs(ctx, req.(*QueryLastInflationInputsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_InflationHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryInflationHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).InflationHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.mint.v1beta1.Query/InflationHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).InflationHistory(ctx, req.(*QueryInflationHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProjectedProvisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProjectedProvisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProjectedProvisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.mint.v1beta1.Query/ProjectedProvisions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProjectedProvisions(ctx, req.(*QueryProjectedProvisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.mint.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -468,6 +852,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AnnualProvisions",
 			Handler:    _Query_AnnualProvisions_Handler,
 		},
+		{
+			MethodName: "LastInflationInputs",
+			Handler:    _Query_LastInflationInputs_Handler,
+		},
+		{
+			MethodName: "InflationHistory",
+			Handler:    _Query_InflationHistory_Handler,
+		},
+		{
+			MethodName: "ProjectedProvisions",
+			Handler:    _Query_ProjectedProvisions_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/mint/v1beta1/query.proto",
@@ -641,53 +1037,245 @@ func (m *QueryAnnualProvisionsResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryLastInflationInputsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+
+func (m *QueryLastInflationInputsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryLastInflationInputsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryInflationRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryLastInflationInputsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryInflationResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Inflation.Size()
+func (m *QueryLastInflationInputsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLastInflationInputsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Inputs.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInflationHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInflationHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInflationHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInflationHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInflationHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInflationHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProjectedProvisionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProjectedProvisionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProjectedProvisionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.BondedRatio.Size()
+		i -= size
+		if _, err := m.BondedRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProjectedProvisionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProjectedProvisionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProjectedProvisionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.AnnualProvisions.Size()
+		i -= size
+		if _, err := m.AnnualProvisions.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Inflation.Size()
+		i -= size
+		if _, err := m.Inflation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryInflationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryInflationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Inflation.Size()
 	n += 1 + l + sovQuery(uint64(l))
 	return n
 }
@@ -712,6 +1300,74 @@ func (m *QueryAnnualProvisionsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryLastInflationInputsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryLastInflationInputsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Inputs.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryInflationHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryInflationHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryProjectedProvisionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.BondedRatio.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryProjectedProvisionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Inflation.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.AnnualProvisions.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1117,6 +1773,475 @@ func (m *QueryAnnualProvisionsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryLastInflationInputsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLastInflationInputsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLastInflationInputsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLastInflationInputsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLastInflationInputsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLastInflationInputsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inputs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Inputs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInflationHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInflationHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInflationHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInflationHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInflationHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInflationHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Records = append(m.Records, InflationRecord{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProjectedProvisionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProjectedProvisionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProjectedProvisionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BondedRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BondedRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProjectedProvisionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProjectedProvisionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProjectedProvisionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inflation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Inflation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AnnualProvisions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AnnualProvisions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0